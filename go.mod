@@ -1,6 +1,7 @@
 module github.com/OctopusDeploy/terraform-provider-octopusdeploy
 
 require (
+	github.com/dghubble/sling v1.1.0
 	github.com/hashicorp/hcl v1.0.0 // indirect
 	github.com/hashicorp/terraform v0.12.5
 	github.com/hashicorp/yamux v0.0.0-20180917205041-7221087c3d28 // indirect
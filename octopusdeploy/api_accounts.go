@@ -0,0 +1,28 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/dghubble/sling"
+)
+
+// accountService reads accounts of any type. The client's AccountService fails to read account types its
+// AccountType enum doesn't know, such as GoogleCloudAccount.
+type accountService struct {
+	sling *sling.Sling
+}
+
+type account struct {
+	ID          string `json:"Id,omitempty"`
+	Name        string `json:"Name"`
+	AccountType string `json:"AccountType"`
+}
+
+func (s *accountService) Get(accountID string) (*account, error) {
+	output := new(account)
+	if err := apiGet(s.sling, output, fmt.Sprintf("accounts/%s", accountID)); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
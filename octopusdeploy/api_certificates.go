@@ -0,0 +1,33 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/dghubble/sling"
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+)
+
+// certificateService adds the archive endpoints missing from the client's CertificateService
+type certificateService struct {
+	sling *sling.Sling
+}
+
+// Archive archives the certificate. Archived certificates can't be used by new deployments, and can be
+// unarchived.
+func (s *certificateService) Archive(certificateID string) (*octopusdeploy.Certificate, error) {
+	output := new(octopusdeploy.Certificate)
+	if err := apiPost(s.sling, nil, output, fmt.Sprintf("certificates/%s/archive", certificateID)); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *certificateService) Unarchive(certificateID string) (*octopusdeploy.Certificate, error) {
+	output := new(octopusdeploy.Certificate)
+	if err := apiPost(s.sling, nil, output, fmt.Sprintf("certificates/%s/unarchive", certificateID)); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,103 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/dghubble/sling"
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+)
+
+// channelService replaces the client's ChannelService, which serialises TenantTags as TenantedDeploymentMode,
+// has no ActionPackages on its rules, and lists channels from /channel
+type channelService struct {
+	sling *sling.Sling
+}
+
+type channels struct {
+	Items []channel `json:"Items"`
+	octopusdeploy.PagedResults
+}
+
+type channel struct {
+	ID          string        `json:"Id,omitempty"`
+	Name        string        `json:"Name"`
+	Description string        `json:"Description"`
+	ProjectID   string        `json:"ProjectId"`
+	LifecycleID string        `json:"LifecycleId,omitempty"`
+	IsDefault   bool          `json:"IsDefault"`
+	Rules       []channelRule `json:"Rules"`
+	TenantTags  []string      `json:"TenantTags"`
+}
+
+type channelRule struct {
+	ID string `json:"Id,omitempty"`
+
+	// The package references of the deployment actions the rule applies to
+	ActionPackages []deploymentActionPackage `json:"ActionPackages"`
+
+	// Pre-release tag
+	Tag string `json:"Tag,omitempty"`
+
+	// Use the NuGet or Maven versioning syntax (depending on the feed type) to specify the range of versions
+	// to include
+	VersionRange string `json:"VersionRange,omitempty"`
+}
+
+// deploymentActionPackage is a package referenced by a deployment action. The primary package of an action
+// has an empty PackageReference.
+type deploymentActionPackage struct {
+	DeploymentAction string `json:"DeploymentAction"`
+	PackageReference string `json:"PackageReference,omitempty"`
+}
+
+func (s *channelService) Get(channelID string) (*channel, error) {
+	output := new(channel)
+	if err := apiGet(s.sling, output, fmt.Sprintf("channels/%s", channelID)); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// GetByProject returns the channels of the project
+func (s *channelService) GetByProject(projectID string) ([]channel, error) {
+	var all []channel
+
+	path := fmt.Sprintf("projects/%s/channels", projectID)
+	loadNextPage := true
+
+	for loadNextPage {
+		page := new(channels)
+		if err := apiGet(s.sling, page, path); err != nil {
+			return nil, err
+		}
+
+		all = append(all, page.Items...)
+
+		path, loadNextPage = octopusdeploy.LoadNextPage(page.PagedResults)
+	}
+
+	return all, nil
+}
+
+func (s *channelService) Add(input *channel) (*channel, error) {
+	output := new(channel)
+	if err := apiAdd(s.sling, input, output, "channels"); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *channelService) Update(input *channel) (*channel, error) {
+	output := new(channel)
+	if err := apiUpdate(s.sling, input, output, fmt.Sprintf("channels/%s", input.ID)); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *channelService) Delete(channelID string) error {
+	return apiDelete(s.sling, fmt.Sprintf("channels/%s", channelID))
+}
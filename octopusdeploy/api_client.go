@@ -0,0 +1,80 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/dghubble/sling"
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+)
+
+// apiClient calls the Octopus Deploy API endpoints the go-octopusdeploy client doesn't support, or gets wrong.
+// Its services are written like the client's, so they can move into the client once it supports them.
+type apiClient struct {
+	Account        *accountService
+	Certificate    *certificateService
+	Channel        *channelService
+	Machine        *machineService
+	Runbook        *runbookService
+	RunbookProcess *runbookProcessService
+	RunbookTrigger *runbookTriggerService
+	WorkerPool     *workerPoolService
+}
+
+// newAPIClient returns an apiClient for the space, or for the default space when spaceID is empty
+func newAPIClient(httpClient *http.Client, octopusURL, octopusAPIKey, spaceID string) *apiClient {
+	baseURLWithAPI := fmt.Sprintf("%s/api/", strings.TrimRight(octopusURL, "/"))
+	if spaceID != "" {
+		baseURLWithAPI = fmt.Sprintf("%s%s/", baseURLWithAPI, spaceID)
+	}
+
+	base := sling.New().Client(httpClient).Base(baseURLWithAPI).Set("X-Octopus-ApiKey", octopusAPIKey)
+
+	return &apiClient{
+		Account:        &accountService{sling: base.New()},
+		Certificate:    &certificateService{sling: base.New()},
+		Channel:        &channelService{sling: base.New()},
+		Machine:        &machineService{sling: base.New()},
+		Runbook:        &runbookService{sling: base.New()},
+		RunbookProcess: &runbookProcessService{sling: base.New()},
+		RunbookTrigger: &runbookTriggerService{sling: base.New()},
+		WorkerPool:     &workerPoolService{sling: base.New()},
+	}
+}
+
+func apiGet(sling *sling.Sling, output interface{}, path string) error {
+	octopusDeployError := new(octopusdeploy.APIError)
+	resp, err := sling.New().Get(path).Receive(output, octopusDeployError)
+
+	return octopusdeploy.APIErrorChecker(path, resp, http.StatusOK, err, octopusDeployError)
+}
+
+func apiAdd(sling *sling.Sling, input, output interface{}, path string) error {
+	octopusDeployError := new(octopusdeploy.APIError)
+	resp, err := sling.New().Post(path).BodyJSON(input).Receive(output, octopusDeployError)
+
+	return octopusdeploy.APIErrorChecker(path, resp, http.StatusCreated, err, octopusDeployError)
+}
+
+// apiPost posts to an endpoint that acts on an existing item, rather than adding one
+func apiPost(sling *sling.Sling, input, output interface{}, path string) error {
+	octopusDeployError := new(octopusdeploy.APIError)
+	resp, err := sling.New().Post(path).BodyJSON(input).Receive(output, octopusDeployError)
+
+	return octopusdeploy.APIErrorChecker(path, resp, http.StatusOK, err, octopusDeployError)
+}
+
+func apiUpdate(sling *sling.Sling, input, output interface{}, path string) error {
+	octopusDeployError := new(octopusdeploy.APIError)
+	resp, err := sling.New().Put(path).BodyJSON(input).Receive(output, octopusDeployError)
+
+	return octopusdeploy.APIErrorChecker(path, resp, http.StatusOK, err, octopusDeployError)
+}
+
+func apiDelete(sling *sling.Sling, path string) error {
+	octopusDeployError := new(octopusdeploy.APIError)
+	resp, err := sling.New().Delete(path).Receive(nil, octopusDeployError)
+
+	return octopusdeploy.APIErrorChecker(path, resp, http.StatusOK, err, octopusDeployError)
+}
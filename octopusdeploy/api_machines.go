@@ -0,0 +1,43 @@
+package octopusdeploy
+
+import (
+	"github.com/dghubble/sling"
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+)
+
+// machineService lists machines with their health status, which the client's Machine doesn't have
+type machineService struct {
+	sling *sling.Sling
+}
+
+type machines struct {
+	Items []machine `json:"Items"`
+	octopusdeploy.PagedResults
+}
+
+type machine struct {
+	octopusdeploy.Machine
+
+	// Healthy, Unhealthy, HasWarnings, Unavailable or Unknown
+	HealthStatus string `json:"HealthStatus"`
+}
+
+func (s *machineService) GetAll() ([]machine, error) {
+	var all []machine
+
+	path := "machines"
+	loadNextPage := true
+
+	for loadNextPage {
+		page := new(machines)
+		if err := apiGet(s.sling, page, path); err != nil {
+			return nil, err
+		}
+
+		all = append(all, page.Items...)
+
+		path, loadNextPage = octopusdeploy.LoadNextPage(page.PagedResults)
+	}
+
+	return all, nil
+}
@@ -0,0 +1,72 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/dghubble/sling"
+)
+
+// runbookTriggerService manages scheduled triggers that run a runbook. The client's ProjectTrigger only
+// models deployment target triggers.
+type runbookTriggerService struct {
+	sling *sling.Sling
+}
+
+type runbookScheduledTrigger struct {
+	ID          string                        `json:"Id,omitempty"`
+	Name        string                        `json:"Name"`
+	Description string                        `json:"Description"`
+	ProjectID   string                        `json:"ProjectId"`
+	IsDisabled  bool                          `json:"IsDisabled"`
+	Filter      runbookScheduledTriggerFilter `json:"Filter"`
+	Action      runbookScheduledTriggerAction `json:"Action"`
+}
+
+// runbookScheduledTriggerFilter is the schedule of the trigger. OnceDailySchedule filters use StartTime and
+// DaysOfWeek, and CronExpressionSchedule filters use CronExpression.
+type runbookScheduledTriggerFilter struct {
+	FilterType     string   `json:"FilterType"`
+	Timezone       string   `json:"Timezone,omitempty"`
+	StartTime      string   `json:"StartTime,omitempty"`
+	DaysOfWeek     []string `json:"DaysOfWeek,omitempty"`
+	CronExpression string   `json:"CronExpression,omitempty"`
+}
+
+type runbookScheduledTriggerAction struct {
+	ActionType     string   `json:"ActionType"`
+	RunbookID      string   `json:"RunbookId"`
+	EnvironmentIDs []string `json:"EnvironmentIds"`
+	TenantIDs      []string `json:"TenantIds"`
+	TenantTags     []string `json:"TenantTags"`
+}
+
+func (s *runbookTriggerService) Get(triggerID string) (*runbookScheduledTrigger, error) {
+	output := new(runbookScheduledTrigger)
+	if err := apiGet(s.sling, output, fmt.Sprintf("projecttriggers/%s", triggerID)); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *runbookTriggerService) Add(input *runbookScheduledTrigger) (*runbookScheduledTrigger, error) {
+	output := new(runbookScheduledTrigger)
+	if err := apiAdd(s.sling, input, output, "projecttriggers"); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *runbookTriggerService) Update(input *runbookScheduledTrigger) (*runbookScheduledTrigger, error) {
+	output := new(runbookScheduledTrigger)
+	if err := apiUpdate(s.sling, input, output, fmt.Sprintf("projecttriggers/%s", input.ID)); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *runbookTriggerService) Delete(triggerID string) error {
+	return apiDelete(s.sling, fmt.Sprintf("projecttriggers/%s", triggerID))
+}
@@ -0,0 +1,105 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/dghubble/sling"
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+)
+
+// runbookService manages runbooks, which the client has no service for
+type runbookService struct {
+	sling *sling.Sling
+}
+
+type runbook struct {
+	ID                       string                    `json:"Id,omitempty"`
+	Name                     string                    `json:"Name"`
+	Description              string                    `json:"Description"`
+	ProjectID                string                    `json:"ProjectId"`
+	RunbookProcessID         string                    `json:"RunbookProcessId,omitempty"`
+	MultiTenancyMode         string                    `json:"MultiTenancyMode"`
+	ConnectivityPolicy       runbookConnectivityPolicy `json:"ConnectivityPolicy"`
+	EnvironmentScope         string                    `json:"EnvironmentScope"`
+	Environments             []string                  `json:"Environments"`
+	DefaultGuidedFailureMode string                    `json:"DefaultGuidedFailureMode"`
+	RunRetentionPolicy       runbookRunRetentionPolicy `json:"RunRetentionPolicy"`
+}
+
+type runbookConnectivityPolicy struct {
+	AllowDeploymentsToNoTargets bool     `json:"AllowDeploymentsToNoTargets"`
+	ExcludeUnhealthyTargets     bool     `json:"ExcludeUnhealthyTargets"`
+	SkipMachineBehavior         string   `json:"SkipMachineBehavior"`
+	TargetRoles                 []string `json:"TargetRoles"`
+}
+
+// runbookRunRetentionPolicy is how many runs of the runbook are kept in each environment
+type runbookRunRetentionPolicy struct {
+	QuantityToKeep    int  `json:"QuantityToKeep"`
+	ShouldKeepForever bool `json:"ShouldKeepForever"`
+}
+
+func (s *runbookService) Get(runbookID string) (*runbook, error) {
+	output := new(runbook)
+	if err := apiGet(s.sling, output, fmt.Sprintf("runbooks/%s", runbookID)); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *runbookService) Add(input *runbook) (*runbook, error) {
+	output := new(runbook)
+	if err := apiAdd(s.sling, input, output, "runbooks"); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *runbookService) Update(input *runbook) (*runbook, error) {
+	output := new(runbook)
+	if err := apiUpdate(s.sling, input, output, fmt.Sprintf("runbooks/%s", input.ID)); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *runbookService) Delete(runbookID string) error {
+	return apiDelete(s.sling, fmt.Sprintf("runbooks/%s", runbookID))
+}
+
+// runbookProcessService reads and updates the process of a runbook. Octopus creates the process with the
+// runbook, and deletes it with the runbook.
+type runbookProcessService struct {
+	sling *sling.Sling
+}
+
+// runbookProcess is the process of a runbook. Its steps are the same as those of a deployment process, and
+// an empty Steps removes every step.
+type runbookProcess struct {
+	ID        string                         `json:"Id,omitempty"`
+	ProjectID string                         `json:"ProjectId,omitempty"`
+	RunbookID string                         `json:"RunbookId,omitempty"`
+	Steps     []octopusdeploy.DeploymentStep `json:"Steps"`
+	Version   int32                          `json:"Version"`
+}
+
+func (s *runbookProcessService) Get(runbookProcessID string) (*runbookProcess, error) {
+	output := new(runbookProcess)
+	if err := apiGet(s.sling, output, fmt.Sprintf("runbookProcesses/%s", runbookProcessID)); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *runbookProcessService) Update(input *runbookProcess) (*runbookProcess, error) {
+	output := new(runbookProcess)
+	if err := apiUpdate(s.sling, input, output, fmt.Sprintf("runbookProcesses/%s", input.ID)); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
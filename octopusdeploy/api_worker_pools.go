@@ -0,0 +1,28 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/dghubble/sling"
+)
+
+// workerPoolService reads worker pools, which the client has no service for
+type workerPoolService struct {
+	sling *sling.Sling
+}
+
+type workerPool struct {
+	ID          string `json:"Id,omitempty"`
+	Name        string `json:"Name"`
+	Description string `json:"Description"`
+	IsDefault   bool   `json:"IsDefault"`
+}
+
+func (s *workerPoolService) Get(workerPoolID string) (*workerPool, error) {
+	output := new(workerPool)
+	if err := apiGet(s.sling, output, fmt.Sprintf("workerpools/%s", workerPoolID)); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
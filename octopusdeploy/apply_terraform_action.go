@@ -1,15 +1,77 @@
 package octopusdeploy
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
 	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func getApplyTerraformActionSchema() *schema.Schema {
+// getTerraformActionSchema returns the schema shared by the apply, plan, destroy and plan destroy actions
+func getTerraformActionSchema() *schema.Schema {
 
 	actionSchema, element := getCommonDeploymentActionSchema()
 	addExecutionLocationSchema(element)
+	addWorkerPoolSchema(element)
 	addPrimaryPackageSchema(element, false)
+	element.Schema["primary_package"].Description = "The package containing the Terraform templates"
+
+	element.Schema["template"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "An inline Terraform template, as HCL or JSON. Use either this or primary_package",
+		Optional:    true,
+	}
+
+	element.Schema["template_parameters"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Description: "Values for the variables of an inline template",
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	element.Schema["template_directory"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The directory in the primary package containing the templates. Defaults to the root of the package",
+		Optional:    true,
+	}
+
+	element.Schema["var_files"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Variable files in the primary package to pass to Terraform, relative to the template directory",
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	element.Schema["workspace"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The Terraform workspace to select, or create if it doesn't exist",
+		Optional:    true,
+	}
+
+	element.Schema["allow_plugin_downloads"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Allow Terraform to download the providers the templates need",
+		Optional:    true,
+		Default:     true,
+	}
+
+	element.Schema["plugins_directory"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "A directory containing providers, used instead of downloading them",
+		Optional:    true,
+	}
+
+	element.Schema["custom_terraform_executable"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The path to a Terraform executable to use instead of the one bundled with Octopus",
+		Optional:    true,
+	}
 
 	element.Schema["additional_init_params"] = &schema.Schema{
 		Type:        schema.TypeString,
@@ -17,18 +79,143 @@ func getApplyTerraformActionSchema() *schema.Schema {
 		Optional:    true,
 	}
 
+	element.Schema["additional_action_params"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Additional parameters passed to the apply, plan or destroy command",
+		Optional:    true,
+	}
+
+	element.Schema["aws_account"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Expose the credentials of an AWS account to Terraform",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"variable": {
+					Type:        schema.TypeString,
+					Description: "The name of the project variable holding the AWS account",
+					Optional:    true,
+				},
+				"use_instance_role": {
+					Type:        schema.TypeBool,
+					Description: "Use the credentials of the EC2 instance the step runs on instead of an AWS account",
+					Optional:    true,
+					Default:     false,
+				},
+				"region": {
+					Type:        schema.TypeString,
+					Description: "The default AWS region",
+					Optional:    true,
+				},
+			},
+		},
+	}
+
+	element.Schema["azure_account"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Expose the credentials of an Azure account to Terraform",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"variable": {
+					Type:        schema.TypeString,
+					Description: "The name of the project variable holding the Azure account",
+					Required:    true,
+				},
+			},
+		},
+	}
+
 	return actionSchema
 }
 
-func buildApplyTerraformActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+// validateTerraformAction checks that the templates come either inline or from the primary package, and that
+// at most one managed account is bound
+func validateTerraformAction(tfAction map[string]interface{}) error {
+	name := tfAction["name"].(string)
+	hasTemplate := tfAction["template"].(string) != ""
+	hasPackage := tfAction["primary_package"].(*schema.Set).Len() > 0
+
+	if hasTemplate == hasPackage {
+		return fmt.Errorf("action %s: exactly one of template and primary_package must be set", name)
+	}
+
+	if hasTemplate && (tfAction["template_directory"].(string) != "" || len(tfAction["var_files"].([]interface{})) > 0) {
+		return fmt.Errorf("action %s: template_directory and var_files can only be used with primary_package", name)
+	}
+
+	if hasPackage && len(tfAction["template_parameters"].(map[string]interface{})) > 0 {
+		return fmt.Errorf("action %s: template_parameters can only be used with an inline template, use var_files with primary_package", name)
+	}
+
+	tfAwsAccount := getSingleBlock(tfAction["aws_account"])
+
+	if tfAwsAccount != nil && getSingleBlock(tfAction["azure_account"]) != nil {
+		return fmt.Errorf("action %s: only one of aws_account and azure_account can be set", name)
+	}
+
+	if tfAwsAccount != nil && (tfAwsAccount["variable"].(string) != "") == tfAwsAccount["use_instance_role"].(bool) {
+		return fmt.Errorf("action %s: aws_account: exactly one of variable and use_instance_role must be set", name)
+	}
+
+	return nil
+}
+
+func buildTerraformActionResource(tfAction map[string]interface{}, actionType string) octopusdeploy.DeploymentAction {
 	resource := buildDeploymentActionResource(tfAction)
 
-	resource.ActionType = "Octopus.TerraformApply"
+	resource.ActionType = actionType
 	resource.Properties["Octopus.Action.Terraform.AdditionalInitParams"] = tfAction["additional_init_params"].(string)
-	resource.Properties["Octopus.Action.Terraform.AllowPluginDownloads"] = "True"
+	resource.Properties["Octopus.Action.Terraform.AdditionalActionParams"] = tfAction["additional_action_params"].(string)
+	resource.Properties["Octopus.Action.Terraform.AllowPluginDownloads"] = formatBool(tfAction["allow_plugin_downloads"].(bool))
+	resource.Properties["Octopus.Action.Terraform.PluginsDirectory"] = tfAction["plugins_directory"].(string)
+	resource.Properties["Octopus.Action.Terraform.CustomTerraformExecutable"] = tfAction["custom_terraform_executable"].(string)
+	resource.Properties["Octopus.Action.Terraform.Workspace"] = tfAction["workspace"].(string)
+
+	if template := tfAction["template"].(string); template != "" {
+		resource.Properties["Octopus.Action.Script.ScriptSource"] = scriptSourceInline
+		resource.Properties["Octopus.Action.Terraform.Template"] = template
+
+		j, _ := json.Marshal(buildStringMap(tfAction["template_parameters"]))
+		resource.Properties["Octopus.Action.Terraform.TemplateParameters"] = string(j)
+	} else {
+		resource.Properties["Octopus.Action.Script.ScriptSource"] = scriptSourcePackage
+		resource.Properties["Octopus.Action.Terraform.TemplateDirectory"] = tfAction["template_directory"].(string)
+		resource.Properties["Octopus.Action.Terraform.VarFiles"] = strings.Join(getSliceFromTerraformTypeList(tfAction["var_files"]), "\n")
+	}
+
 	resource.Properties["Octopus.Action.Terraform.ManagedAccount"] = "None"
 
-	resource.Properties["Octopus.Action.Script.ScriptSource"] = "Package"
+	if tfAwsAccount := getSingleBlock(tfAction["aws_account"]); tfAwsAccount != nil {
+		resource.Properties["Octopus.Action.Terraform.ManagedAccount"] = "AWS"
+		resource.Properties["Octopus.Action.AwsAccount.Variable"] = tfAwsAccount["variable"].(string)
+		resource.Properties["Octopus.Action.AwsAccount.UseInstanceRole"] = formatBool(tfAwsAccount["use_instance_role"].(bool))
+		resource.Properties["Octopus.Action.Aws.Region"] = tfAwsAccount["region"].(string)
+	}
+
+	if tfAzureAccount := getSingleBlock(tfAction["azure_account"]); tfAzureAccount != nil {
+		resource.Properties["Octopus.Action.Terraform.ManagedAccount"] = "Azure"
+		resource.Properties["Octopus.Action.Terraform.AzureAccount"] = "True"
+		resource.Properties["Octopus.Action.AzureAccount.Variable"] = tfAzureAccount["variable"].(string)
+	}
 
 	return resource
 }
+
+func buildApplyTerraformActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	return buildTerraformActionResource(tfAction, "Octopus.TerraformApply")
+}
+
+func buildPlanTerraformActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	return buildTerraformActionResource(tfAction, "Octopus.TerraformPlan")
+}
+
+func buildDestroyTerraformActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	return buildTerraformActionResource(tfAction, "Octopus.TerraformDestroy")
+}
+
+func buildPlanDestroyTerraformActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	return buildTerraformActionResource(tfAction, "Octopus.TerraformPlanDestroy")
+}
@@ -2,9 +2,9 @@ package octopusdeploy
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
-	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -43,7 +43,7 @@ func testAccApplyTerraformAction() string {
 
 func testAccCheckApplyTerraformAction() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 
 		process, err := getDeploymentProcess(s, client)
 		if err != nil {
@@ -63,3 +63,114 @@ func testAccCheckApplyTerraformAction() resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestAccOctopusDeployPlanTerraformActionInline(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanTerraformActionInline(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanTerraformActionInline(),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployApplyTerraformActionBothAccounts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBuildTestAction(`
+					apply_terraform_action {
+						name = "Apply Terraform"
+						run_on_server = true
+						template = "{}"
+
+						aws_account {
+							use_instance_role = true
+						}
+
+						azure_account {
+							variable = "Azure Account"
+						}
+					}
+				`),
+				ExpectError: regexp.MustCompile("only one of aws_account and azure_account can be set"),
+			},
+		},
+	})
+}
+
+func testAccPlanTerraformActionInline() string {
+	return testAccBuildTestAction(`
+		plan_terraform_action {
+			name = "Plan Terraform"
+			run_on_server = true
+			workspace = "#{Octopus.Environment.Name}"
+			allow_plugin_downloads = false
+			additional_action_params = "-refresh=false"
+
+			template = <<EOT
+variable "bucket" {}
+
+resource "aws_s3_bucket" "bucket" {
+  bucket = "$${var.bucket}"
+}
+EOT
+
+			template_parameters = {
+				bucket = "my-bucket"
+			}
+
+			aws_account {
+				variable = "AWS Account"
+				region = "us-east-1"
+			}
+		}
+	`)
+}
+
+func testAccCheckPlanTerraformActionInline() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.TerraformPlan" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Octopus.Action.Script.ScriptSource"] != "Inline" {
+			return fmt.Errorf("ScriptSource is incorrect: %s", action.Properties["Octopus.Action.Script.ScriptSource"])
+		}
+
+		if action.Properties["Octopus.Action.Terraform.TemplateParameters"] != `{"bucket":"my-bucket"}` {
+			return fmt.Errorf("TemplateParameters is incorrect: %s", action.Properties["Octopus.Action.Terraform.TemplateParameters"])
+		}
+
+		if action.Properties["Octopus.Action.Terraform.AllowPluginDownloads"] != "False" {
+			return fmt.Errorf("AllowPluginDownloads is incorrect: %s", action.Properties["Octopus.Action.Terraform.AllowPluginDownloads"])
+		}
+
+		if action.Properties["Octopus.Action.Terraform.ManagedAccount"] != "AWS" {
+			return fmt.Errorf("ManagedAccount is incorrect: %s", action.Properties["Octopus.Action.Terraform.ManagedAccount"])
+		}
+
+		if action.Properties["Octopus.Action.AwsAccount.Variable"] != "AWS Account" {
+			return fmt.Errorf("AwsAccount.Variable is incorrect: %s", action.Properties["Octopus.Action.AwsAccount.Variable"])
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,111 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// awsKeyValue is an entry of the tags or metadata the AWS actions send as a list of key/value pairs
+type awsKeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// buildAwsKeyValues orders the entries by key, so the serialised property doesn't change between applies
+func buildAwsKeyValues(tfMap interface{}) []awsKeyValue {
+	values := buildStringMap(tfMap)
+
+	keyValues := []awsKeyValue{}
+	for _, key := range getSortedKeys(values) {
+		keyValues = append(keyValues, awsKeyValue{Key: key, Value: values[key]})
+	}
+
+	return keyValues
+}
+
+// addAwsAccountSchema adds the region and credentials shared by the AWS actions
+func addAwsAccountSchema(element *schema.Resource) {
+	element.Schema["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The AWS region, e.g. us-east-1. Can be an expression",
+		Required:    true,
+	}
+
+	element.Schema["aws_account_variable"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the project variable holding the AWS account",
+		Optional:    true,
+	}
+
+	element.Schema["use_instance_role"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Use the credentials of the EC2 instance the step runs on instead of an AWS account",
+		Optional:    true,
+		Default:     false,
+	}
+
+	element.Schema["assume_role"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Assume an IAM role with the credentials before running the step",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role_arn": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"session_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"session_duration": {
+					Type:        schema.TypeInt,
+					Description: "The duration of the session in seconds",
+					Optional:    true,
+				},
+				"external_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// validateAwsAccount checks that the action either uses an account variable or the instance role
+func validateAwsAccount(tfAction map[string]interface{}) error {
+	name := tfAction["name"].(string)
+	hasAccount := tfAction["aws_account_variable"].(string) != ""
+	useInstanceRole := tfAction["use_instance_role"].(bool)
+
+	if hasAccount == useInstanceRole {
+		return fmt.Errorf("action %s: exactly one of aws_account_variable and use_instance_role must be set", name)
+	}
+
+	return nil
+}
+
+func buildAwsAccountProperties(tfAction map[string]interface{}) map[string]string {
+	properties := map[string]string{
+		"Octopus.Action.Aws.Region":                 tfAction["region"].(string),
+		"Octopus.Action.AwsAccount.UseInstanceRole": formatBool(tfAction["use_instance_role"].(bool)),
+		"Octopus.Action.AwsAccount.Variable":        tfAction["aws_account_variable"].(string),
+		"Octopus.Action.Aws.AssumeRole":             "False",
+	}
+
+	if tfAssumeRole := getSingleBlock(tfAction["assume_role"]); tfAssumeRole != nil {
+		properties["Octopus.Action.Aws.AssumeRole"] = "True"
+		properties["Octopus.Action.Aws.AssumedRoleArn"] = tfAssumeRole["role_arn"].(string)
+		properties["Octopus.Action.Aws.AssumedRoleSession"] = tfAssumeRole["session_name"].(string)
+		properties["Octopus.Action.Aws.AssumeRoleExternalId"] = tfAssumeRole["external_id"].(string)
+
+		if duration := tfAssumeRole["session_duration"].(int); duration > 0 {
+			properties["Octopus.Action.Aws.AssumeRoleSessionDurationSeconds"] = strconv.Itoa(duration)
+		}
+	}
+
+	return properties
+}
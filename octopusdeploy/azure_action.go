@@ -0,0 +1,52 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/config/hcl2shim"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// addAzureAccountSchema adds the Azure account used by the Azure actions
+func addAzureAccountSchema(element *schema.Resource) {
+	element.Schema["azure_account_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The ID of the Azure subscription or service principal account the step uses",
+		Required:    true,
+	}
+}
+
+// validateAzureAccount checks that the account of the action exists and is an Azure account. Accounts that
+// are not known until apply, e.g. because they are created in the same plan, are not checked.
+func validateAzureAccount(client *octopusdeploy.Client, tfAction map[string]interface{}) error {
+	accountID := tfAction["azure_account_id"].(string)
+	if accountID == "" || accountID == hcl2shim.UnknownVariableValue {
+		return nil
+	}
+
+	name := tfAction["name"].(string)
+
+	account, err := client.Account.Get(accountID)
+	if err == octopusdeploy.ErrItemNotFound {
+		return fmt.Errorf("action %s: account %s does not exist", name, accountID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("action %s: error reading account %s: %s", name, accountID, err.Error())
+	}
+
+	for _, accountType := range variableAccountTypes["AzureAccount"] {
+		if account.AccountType.String() == accountType {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("action %s: account %s is a %s account, an Azure subscription or service principal account is required", name, accountID, account.AccountType)
+}
+
+func buildAzureAccountProperties(tfAction map[string]interface{}) map[string]string {
+	return map[string]string{
+		"Octopus.Action.Azure.AccountId": tfAction["azure_account_id"].(string),
+	}
+}
@@ -7,22 +7,37 @@ import (
 	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
 )
 
-// Config holds Address and the APIKey of the Octopus Deploy server
+// Config holds Address and the APIKey of the Octopus Deploy server, and the settings of the provider
 type Config struct {
 	Address string
 	APIKey  string
 	Space   string
+
+	// CertificateExpiryWarningDays is the number of days before a certificate expires that variables
+	// referencing it log a warning. Zero disables the warning.
+	CertificateExpiryWarningDays int
+
+	// CertificateExpiryFailPlan makes an expiring certificate fail the plan instead of logging a warning
+	CertificateExpiryFailPlan bool
+}
+
+// providerMeta is passed to every resource and data source: the Octopus Deploy client, the client for the
+// endpoints it doesn't support, and the configuration they were created from
+type providerMeta struct {
+	Client *octopusdeploy.Client
+	API    *apiClient
+	Config Config
 }
 
-// Client returns a new Octopus Deploy client
-func (c *Config) Client() (*octopusdeploy.Client, error) {
+// Client returns a new Octopus Deploy client, and an apiClient for the same space
+func (c *Config) Client() (*octopusdeploy.Client, *apiClient, error) {
 	client := octopusdeploy.NewClient(&(http.Client{}), c.Address, c.APIKey)
 
 	if c.Space == "" {
 
 		log.Printf("[INFO] Octopus Deploy Client configured against default space")
 
-		return client, nil
+		return client, newAPIClient(&(http.Client{}), c.Address, c.APIKey, ""), nil
 	}
 
 	log.Printf("[INFO] Octopus Deploy Client will be scoped to %s space", c.Space)
@@ -30,12 +45,12 @@ func (c *Config) Client() (*octopusdeploy.Client, error) {
 	space, err := client.Space.GetByName(c.Space)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	scopedClient := octopusdeploy.ForSpace(&(http.Client{}), c.Address, c.APIKey, space)
 
 	log.Printf("[INFO] Octopus Deploy Client configured against %s space", c.Space)
 
-	return scopedClient, nil
+	return scopedClient, newAPIClient(&(http.Client{}), c.Address, c.APIKey, space.ID), nil
 }
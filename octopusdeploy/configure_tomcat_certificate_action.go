@@ -0,0 +1,80 @@
+package octopusdeploy
+
+import (
+	"strconv"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func getConfigureTomcatCertificateActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addJavaKeystoreSchema(element)
+
+	element.Schema["catalina_home"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The CATALINA_HOME directory of the Tomcat installation",
+		Required:    true,
+	}
+
+	element.Schema["catalina_base"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The CATALINA_BASE directory of the Tomcat instance. Defaults to catalina_home",
+		Optional:    true,
+	}
+
+	element.Schema["implementation"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "The HTTPS connector implementation, one of 'NIO', 'NIO2', 'APR' or 'BIO'",
+		Optional:     true,
+		Default:      "NIO",
+		ValidateFunc: validateValueFunc([]string{"NIO", "NIO2", "APR", "BIO"}),
+	}
+
+	element.Schema["port"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "The port of the HTTPS connector",
+		Required:    true,
+	}
+
+	element.Schema["service"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the Tomcat service the connector belongs to",
+		Optional:    true,
+		Default:     "Catalina",
+	}
+
+	element.Schema["hostname"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The SNI host name the certificate is used for",
+		Optional:    true,
+	}
+
+	element.Schema["default_host"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Use the certificate for requests that don't match another host name",
+		Optional:    true,
+		Default:     true,
+	}
+
+	return actionSchema
+}
+
+func buildConfigureTomcatCertificateActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.TomcatDeployCertificate"
+
+	resource.Properties = merge(resource.Properties, buildJavaKeystoreProperties(tfAction))
+
+	resource.Properties["Tomcat.Certificate.CatalinaHome"] = tfAction["catalina_home"].(string)
+	resource.Properties["Tomcat.Certificate.CatalinaBase"] = tfAction["catalina_base"].(string)
+	resource.Properties["Tomcat.Certificate.Implementation"] = tfAction["implementation"].(string)
+	resource.Properties["Tomcat.Certificate.Port"] = strconv.Itoa(tfAction["port"].(int))
+	resource.Properties["Tomcat.Certificate.Service"] = tfAction["service"].(string)
+	resource.Properties["Tomcat.Certificate.Hostname"] = tfAction["hostname"].(string)
+	resource.Properties["Tomcat.Certificate.Default"] = formatBool(tfAction["default_host"].(bool))
+
+	return resource
+}
@@ -0,0 +1,72 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployConfigureTomcatCertificateAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigureTomcatCertificateAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigureTomcatCertificateAction(),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigureTomcatCertificateAction() string {
+	return testAccBuildTestAction(`
+		configure_tomcat_certificate_action {
+			name = "Configure HTTPS"
+			certificate_variable = "Certificate"
+			catalina_home = "/opt/tomcat"
+			port = 8443
+			implementation = "NIO2"
+		}
+	`)
+}
+
+func testAccCheckConfigureTomcatCertificateAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.TomcatDeployCertificate" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Java.Certificate.Variable"] != "Certificate" {
+			return fmt.Errorf("Variable is incorrect: %s", action.Properties["Java.Certificate.Variable"])
+		}
+
+		if action.Properties["Tomcat.Certificate.CatalinaHome"] != "/opt/tomcat" {
+			return fmt.Errorf("CatalinaHome is incorrect: %s", action.Properties["Tomcat.Certificate.CatalinaHome"])
+		}
+
+		if action.Properties["Tomcat.Certificate.Port"] != "8443" {
+			return fmt.Errorf("Port is incorrect: %s", action.Properties["Tomcat.Certificate.Port"])
+		}
+
+		if action.Properties["Tomcat.Certificate.Implementation"] != "NIO2" {
+			return fmt.Errorf("Implementation is incorrect: %s", action.Properties["Tomcat.Certificate.Implementation"])
+		}
+
+		return nil
+	}
+}
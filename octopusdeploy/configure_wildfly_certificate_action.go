@@ -0,0 +1,69 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func getConfigureWildFlyCertificateActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addWildFlyManagementSchema(element)
+	addJavaKeystoreSchema(element)
+
+	element.Schema["https_port_binding_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the socket binding of the HTTPS port",
+		Optional:    true,
+		Default:     "https",
+	}
+
+	element.Schema["secure_realm_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the security realm to create for the certificate",
+		Optional:    true,
+	}
+
+	element.Schema["certificate_profiles"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The profiles to configure the certificate in, for domain servers",
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return actionSchema
+}
+
+// validateConfigureWildFlyCertificateAction checks the credentials, and that domain servers name the profiles
+// to configure
+func validateConfigureWildFlyCertificateAction(tfAction map[string]interface{}) error {
+	if err := validateJavaCredentials(tfAction); err != nil {
+		return err
+	}
+
+	if tfAction["server_type"].(string) == "Domain" && len(tfAction["certificate_profiles"].([]interface{})) == 0 {
+		return fmt.Errorf("action %s: certificate_profiles must be set when server_type is 'Domain'", tfAction["name"].(string))
+	}
+
+	return nil
+}
+
+func buildConfigureWildFlyCertificateActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.WildFlyCertDeploy"
+
+	resource.Properties = merge(resource.Properties, buildWildFlyManagementProperties(tfAction))
+	resource.Properties = merge(resource.Properties, buildJavaKeystoreProperties(tfAction))
+
+	resource.Properties["WildFly.Deploy.HTTPSPortBindingName"] = tfAction["https_port_binding_name"].(string)
+	resource.Properties["WildFly.Deploy.SecureRealmName"] = tfAction["secure_realm_name"].(string)
+	resource.Properties["WildFly.Deploy.CertificateProfiles"] = strings.Join(getSliceFromTerraformTypeList(tfAction["certificate_profiles"]), ",")
+
+	return resource
+}
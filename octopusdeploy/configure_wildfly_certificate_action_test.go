@@ -0,0 +1,87 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployConfigureWildFlyCertificateAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigureWildFlyCertificateAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigureWildFlyCertificateAction(),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployConfigureWildFlyCertificateActionMissingProfiles(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBuildTestAction(`
+					configure_wildfly_certificate_action {
+						name = "Configure HTTPS"
+						certificate_variable = "Certificate"
+						server_type = "Domain"
+					}
+				`),
+				ExpectError: regexp.MustCompile("certificate_profiles must be set when server_type is 'Domain'"),
+			},
+		},
+	})
+}
+
+func testAccConfigureWildFlyCertificateAction() string {
+	return testAccBuildTestAction(`
+		configure_wildfly_certificate_action {
+			name = "Configure HTTPS"
+			certificate_variable = "Certificate"
+			server_type = "Domain"
+			certificate_profiles = ["default", "full"]
+		}
+	`)
+}
+
+func testAccCheckConfigureWildFlyCertificateAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.WildFlyCertDeploy" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Java.Certificate.Variable"] != "Certificate" {
+			return fmt.Errorf("Variable is incorrect: %s", action.Properties["Java.Certificate.Variable"])
+		}
+
+		if action.Properties["WildFly.Deploy.CertificateProfiles"] != "default,full" {
+			return fmt.Errorf("CertificateProfiles is incorrect: %s", action.Properties["WildFly.Deploy.CertificateProfiles"])
+		}
+
+		if action.Properties["WildFly.Deploy.HTTPSPortBindingName"] != "https" {
+			return fmt.Errorf("HTTPSPortBindingName is incorrect: %s", action.Properties["WildFly.Deploy.HTTPSPortBindingName"])
+		}
+
+		return nil
+	}
+}
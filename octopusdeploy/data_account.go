@@ -22,7 +22,7 @@ func dataAccount() *schema.Resource {
 }
 
 func dataAccountReadByName(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	accountName := d.Get("name")
 
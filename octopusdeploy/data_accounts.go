@@ -0,0 +1,58 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataAccounts() *schema.Resource {
+	dataSchema := getListDataSourceSchema()
+	dataSchema["account_types"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Only include accounts of these types",
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validateValueFunc(octopusdeploy.AccountTypeNames()),
+		},
+	}
+	dataSchema["environment_ids"] = getListDataSourceFilterSchema("Only include accounts available to any of these environments")
+	dataSchema["tenant_tags"] = getListDataSourceFilterSchema("Only include accounts with any of these tenant tags")
+
+	return &schema.Resource{
+		Read:   dataAccountsRead,
+		Schema: dataSchema,
+	}
+}
+
+func dataAccountsRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).Client
+
+	accountTypes := getSliceFromTerraformTypeList(d.Get("account_types"))
+	environmentIDs := getSliceFromTerraformTypeList(d.Get("environment_ids"))
+	tenantTags := getSliceFromTerraformTypeList(d.Get("tenant_tags"))
+
+	accounts, err := client.Account.GetAll()
+
+	if err != nil {
+		return fmt.Errorf("error reading accounts: %s", err.Error())
+	}
+
+	result := newListDataSourceResult(d)
+
+	for _, account := range *accounts {
+		if !matchesAnyFilter([]string{account.AccountType.String()}, accountTypes) ||
+			!matchesAnyFilter(account.EnvironmentIDs, environmentIDs) ||
+			!matchesAnyFilter(account.TenantTags, tenantTags) {
+			continue
+		}
+
+		result.add(account.ID, account.Name)
+	}
+
+	result.set(d)
+
+	return nil
+}
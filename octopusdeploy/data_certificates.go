@@ -0,0 +1,129 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataCertificates() *schema.Resource {
+	dataSchema := getListDataSourceSchema()
+	dataSchema["environment_ids"] = getListDataSourceFilterSchema("Only include certificates scoped to any of these environments")
+	dataSchema["expires_within_days"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "Only include certificates that have expired, or expire within this many days",
+		Optional:    true,
+	}
+	dataSchema["certificates"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The matching certificates, in the same order as ids",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"thumbprint": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"subject_common_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"not_before": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"not_after": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"is_expired": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"environment_ids": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataCertificatesRead,
+		Schema: dataSchema,
+	}
+}
+
+func dataCertificatesRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).Client
+
+	environmentIds := getSliceFromTerraformTypeList(d.Get("environment_ids"))
+	// GetOkExists, as 0 days asks for the certificates that have already expired
+	expiresWithinDays, filterExpiry := d.GetOkExists("expires_within_days")
+
+	certificates, err := client.Certificate.GetAll()
+
+	if err != nil {
+		return fmt.Errorf("error reading certificates: %s", err.Error())
+	}
+
+	result := newListDataSourceResult(d)
+	tfCertificates := []interface{}{}
+	now := time.Now()
+
+	for i := range *certificates {
+		certificate := &(*certificates)[i]
+
+		// Replaced certificates are archived, and can't be referenced by new variables
+		if certificate.Archived != "" {
+			continue
+		}
+
+		if !matchesAnyFilter(certificate.EnvironmentIds, environmentIds) {
+			continue
+		}
+
+		if filterExpiry {
+			expires, err := certificateExpiresWithin(certificate, expiresWithinDays.(int), now)
+			if err != nil {
+				return err
+			}
+
+			if !expires {
+				continue
+			}
+		}
+
+		if !result.add(certificate.ID, certificate.Name) {
+			continue
+		}
+
+		tfCertificates = append(tfCertificates, map[string]interface{}{
+			"id":                  certificate.ID,
+			"name":                certificate.Name,
+			"thumbprint":          certificate.Thumbprint,
+			"subject_common_name": certificate.SubjectCommonName,
+			"not_before":          certificate.NotBefore,
+			"not_after":           certificate.NotAfter,
+			"is_expired":          certificate.IsExpired,
+			"environment_ids":     certificate.EnvironmentIds,
+		})
+	}
+
+	result.set(d)
+	d.Set("certificates", tfCertificates)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataChannel() *schema.Resource {
+	return &schema.Resource{
+		Read: dataChannelReadByName,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lifecycle_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tenant_tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataChannelReadByName(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta).API
+
+	projectID := d.Get("project_id").(string)
+	channelName := d.Get("name").(string)
+
+	channels, err := api.Channel.GetByProject(projectID)
+
+	if err != nil {
+		return fmt.Errorf("error reading channels of project %s: %s", projectID, err.Error())
+	}
+
+	for _, channel := range channels {
+		if channel.Name != channelName {
+			continue
+		}
+
+		d.SetId(channel.ID)
+		d.Set("description", channel.Description)
+		d.Set("lifecycle_id", channel.LifecycleID)
+		d.Set("is_default", channel.IsDefault)
+		d.Set("tenant_tags", channel.TenantTags)
+
+		return nil
+	}
+
+	return fmt.Errorf("project %s has no channel named %s", projectID, channelName)
+}
@@ -33,7 +33,7 @@ func dataEnvironment() *schema.Resource {
 }
 
 func dataEnvironmentReadByName(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	environmentName := d.Get("name")
 	env, err := client.Environment.GetByName(environmentName.(string))
@@ -0,0 +1,34 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataEnvironments() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataEnvironmentsRead,
+		Schema: getListDataSourceSchema(),
+	}
+}
+
+func dataEnvironmentsRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).Client
+
+	environments, err := client.Environment.GetAll()
+
+	if err != nil {
+		return fmt.Errorf("error reading environments: %s", err.Error())
+	}
+
+	result := newListDataSourceResult(d)
+
+	for _, environment := range *environments {
+		result.add(environment.ID, environment.Name)
+	}
+
+	result.set(d)
+
+	return nil
+}
@@ -22,7 +22,7 @@ func dataFeed() *schema.Resource {
 }
 
 func dataFeedReadByName(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	feedName := d.Get("name")
 
@@ -0,0 +1,43 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataFeeds() *schema.Resource {
+	dataSchema := getListDataSourceSchema()
+	dataSchema["feed_types"] = getListDataSourceFilterSchema("Only include feeds of these types, e.g. NuGet, Docker, Helm, Maven or GitHub")
+
+	return &schema.Resource{
+		Read:   dataFeedsRead,
+		Schema: dataSchema,
+	}
+}
+
+func dataFeedsRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).Client
+
+	feedTypes := getSliceFromTerraformTypeList(d.Get("feed_types"))
+
+	feeds, err := client.Feed.GetAll()
+
+	if err != nil {
+		return fmt.Errorf("error reading feeds: %s", err.Error())
+	}
+
+	result := newListDataSourceResult(d)
+
+	for _, feed := range *feeds {
+		if !matchesAnyFilter([]string{feed.FeedType}, feedTypes) {
+			continue
+		}
+
+		result.add(feed.ID, feed.Name)
+	}
+
+	result.set(d)
+
+	return nil
+}
@@ -21,7 +21,7 @@ func dataLibraryVariableSet() *schema.Resource {
 }
 
 func dataLibraryVariableSetReadByName(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	name := d.Get("name")
 
@@ -25,7 +25,7 @@ func dataLifecycle() *schema.Resource {
 }
 
 func dataLifecycleReadByName(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	lifecycleName := d.Get("name")
 
@@ -0,0 +1,34 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataLifecycles() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataLifecyclesRead,
+		Schema: getListDataSourceSchema(),
+	}
+}
+
+func dataLifecyclesRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).Client
+
+	lifecycles, err := client.Lifecycle.GetAll()
+
+	if err != nil {
+		return fmt.Errorf("error reading lifecycles: %s", err.Error())
+	}
+
+	result := newListDataSourceResult(d)
+
+	for _, lifecycle := range *lifecycles {
+		result.add(lifecycle.ID, lifecycle.Name)
+	}
+
+	result.set(d)
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package octopusdeploy
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// getListDataSourceSchema returns the filters and results shared by the data sources that return lists
+func getListDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"partial_name": {
+			Type:        schema.TypeString,
+			Description: "Only include items whose name contains this value (case insensitive)",
+			Optional:    true,
+		},
+		"filter_ids": getListDataSourceFilterSchema("Only include items with these IDs"),
+		"ids": {
+			Type:        schema.TypeList,
+			Description: "The IDs of the matching items",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"names": {
+			Type:        schema.TypeList,
+			Description: "The names of the matching items, in the same order as ids",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+func getListDataSourceFilterSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// listDataSourceResult collects the items that matched the filters of a list data source
+type listDataSourceResult struct {
+	partialName string
+	filterIDs   []string
+	ids         []string
+	names       []string
+}
+
+func newListDataSourceResult(d *schema.ResourceData) *listDataSourceResult {
+	return &listDataSourceResult{
+		partialName: strings.ToLower(d.Get("partial_name").(string)),
+		filterIDs:   getSliceFromTerraformTypeList(d.Get("filter_ids")),
+	}
+}
+
+// add records the item if it matches the name and ID filters, and returns whether it matched
+func (r *listDataSourceResult) add(id, name string) bool {
+	if r.partialName != "" && !strings.Contains(strings.ToLower(name), r.partialName) {
+		return false
+	}
+
+	if len(r.filterIDs) > 0 && !validateStringInSlice(id, r.filterIDs) {
+		return false
+	}
+
+	r.ids = append(r.ids, id)
+	r.names = append(r.names, name)
+
+	return true
+}
+
+func (r *listDataSourceResult) set(d *schema.ResourceData) {
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(r.ids, ","))))
+	d.Set("ids", r.ids)
+	d.Set("names", r.names)
+}
+
+// matchesAnyFilter returns true if no filter values are given, or any of the values is in the filter
+func matchesAnyFilter(values []string, filter []string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+
+	for _, value := range values {
+		if validateStringInSlice(value, filter) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,269 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestListDataSourceResult(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getListDataSourceSchema(), map[string]interface{}{
+		"partial_name": "WEB",
+		"filter_ids":   []interface{}{"Projects-1", "Projects-3"},
+	})
+
+	result := newListDataSourceResult(d)
+	result.add("Projects-1", "Website")
+	result.add("Projects-2", "Web Api")
+	result.add("Projects-3", "Database")
+
+	if !reflect.DeepEqual(result.ids, []string{"Projects-1"}) {
+		t.Errorf("expected only Projects-1 to match, got %v", result.ids)
+	}
+
+	if !reflect.DeepEqual(result.names, []string{"Website"}) {
+		t.Errorf("expected only Website to match, got %v", result.names)
+	}
+}
+
+func TestMatchesAnyFilter(t *testing.T) {
+	cases := []struct {
+		values  []string
+		filter  []string
+		matches bool
+	}{
+		{[]string{"Web"}, nil, true},
+		{nil, nil, true},
+		{[]string{"Web", "Db"}, []string{"Db"}, true},
+		{[]string{"Web"}, []string{"Db"}, false},
+		{nil, []string{"Db"}, false},
+	}
+
+	for _, c := range cases {
+		if matches := matchesAnyFilter(c.values, c.filter); matches != c.matches {
+			t.Errorf("matchesAnyFilter(%v, %v): expected %t, got %t", c.values, c.filter, c.matches, matches)
+		}
+	}
+}
+
+func TestMachineHealthStatus(t *testing.T) {
+	var page machines
+	if err := json.Unmarshal([]byte(`{
+		"Items": [{"Id": "Machines-1", "Name": "Web", "Roles": ["Web"], "HealthStatus": "HasWarnings"}]
+	}`), &page); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(page.Items) != 1 {
+		t.Fatalf("expected 1 machine, got %d", len(page.Items))
+	}
+
+	machine := page.Items[0]
+
+	if machine.ID != "Machines-1" || machine.Name != "Web" || !reflect.DeepEqual(machine.Roles, []string{"Web"}) {
+		t.Errorf("expected the client's machine fields to be read, got %+v", machine.Machine)
+	}
+
+	if machine.HealthStatus != "HasWarnings" {
+		t.Errorf("expected health status HasWarnings, got %q", machine.HealthStatus)
+	}
+}
+
+func TestAccOctopusDeployDataEnvironments(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "octopusdeploy_environment" "first" {
+						name = "Data Environments First"
+					}
+
+					resource "octopusdeploy_environment" "second" {
+						name = "Data Environments Second"
+					}
+
+					data "octopusdeploy_environments" "test" {
+						partial_name = "data environments"
+						filter_ids   = ["${octopusdeploy_environment.second.id}"]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.octopusdeploy_environments.test", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.octopusdeploy_environments.test", "ids.0", "octopusdeploy_environment.second", "id"),
+					resource.TestCheckResourceAttr("data.octopusdeploy_environments.test", "names.0", "Data Environments Second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployDataLifecycles(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "octopusdeploy_lifecycle" "test" {
+						name = "Data Lifecycles Test"
+					}
+
+					data "octopusdeploy_lifecycles" "test" {
+						partial_name = "${octopusdeploy_lifecycle.test.name}"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.octopusdeploy_lifecycles.test", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.octopusdeploy_lifecycles.test", "ids.0", "octopusdeploy_lifecycle.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployDataProjects(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "octopusdeploy_lifecycle" "test" {
+						name = "Data Projects Lifecycle"
+					}
+
+					resource "octopusdeploy_project_group" "test" {
+						name = "Data Projects Group"
+					}
+
+					resource "octopusdeploy_project" "test" {
+						name             = "Data Projects Test"
+						lifecycle_id     = "${octopusdeploy_lifecycle.test.id}"
+						project_group_id = "${octopusdeploy_project_group.test.id}"
+					}
+
+					data "octopusdeploy_projects" "test" {
+						project_group_ids = ["${octopusdeploy_project.test.project_group_id}"]
+						lifecycle_ids     = ["${octopusdeploy_lifecycle.test.id}"]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.octopusdeploy_projects.test", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.octopusdeploy_projects.test", "ids.0", "octopusdeploy_project.test", "id"),
+					resource.TestCheckResourceAttr("data.octopusdeploy_projects.test", "names.0", "Data Projects Test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployDataMachines(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					%s
+
+					data "octopusdeploy_machines" "test" {
+						roles                = ["Prod"]
+						environment_ids      = ["${octopusdeploy_machine.foomac.environments[0]}"]
+						communication_styles = ["None"]
+					}
+
+					data "octopusdeploy_machines" "none" {
+						environment_ids = ["${octopusdeploy_machine.foomac.environments[0]}"]
+						statuses        = ["NoSuchStatus"]
+					}
+
+					data "octopusdeploy_machines" "unhealthy" {
+						environment_ids = ["${octopusdeploy_machine.foomac.environments[0]}"]
+						health_statuses = ["NoSuchHealthStatus"]
+					}
+				`, testMachineBasic("Data Machines Test")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.octopusdeploy_machines.test", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.octopusdeploy_machines.test", "ids.0", "octopusdeploy_machine.foomac", "id"),
+					resource.TestCheckResourceAttr("data.octopusdeploy_machines.none", "ids.#", "0"),
+					resource.TestCheckResourceAttr("data.octopusdeploy_machines.unhealthy", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployDataAccounts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "octopusdeploy_account" "test" {
+						name            = "Data Accounts Test"
+						account_type    = "AzureServicePrincipal"
+						client_id       = "18eb006b-c3c8-4a72-93cd-fe4b293f82ee"
+						tenant_id       = "18eb006b-c3c8-4a72-93cd-fe4b293f82ee"
+						subscription_id = "18eb006b-c3c8-4a72-93cd-fe4b293f82ee"
+						client_secret   = "18eb006b-c3c8-4a72-93cd-fe4b293f82ee"
+					}
+
+					data "octopusdeploy_accounts" "test" {
+						partial_name  = "${octopusdeploy_account.test.name}"
+						account_types = ["AzureServicePrincipal"]
+					}
+
+					data "octopusdeploy_accounts" "other_type" {
+						partial_name  = "${octopusdeploy_account.test.name}"
+						account_types = ["UsernamePassword"]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.octopusdeploy_accounts.test", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.octopusdeploy_accounts.test", "ids.0", "octopusdeploy_account.test", "id"),
+					resource.TestCheckResourceAttr("data.octopusdeploy_accounts.other_type", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployDataFeeds(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "octopusdeploy_feed" "test" {
+						name      = "Data Feeds Test"
+						feed_type = "NuGet"
+						feed_uri  = "https://api.nuget.org/v3/index.json"
+					}
+
+					data "octopusdeploy_feeds" "test" {
+						partial_name = "${octopusdeploy_feed.test.name}"
+						feed_types   = ["NuGet"]
+					}
+
+					data "octopusdeploy_feeds" "other_type" {
+						partial_name = "${octopusdeploy_feed.test.name}"
+						feed_types   = ["Docker"]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.octopusdeploy_feeds.test", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.octopusdeploy_feeds.test", "ids.0", "octopusdeploy_feed.test", "id"),
+					resource.TestCheckResourceAttr("data.octopusdeploy_feeds.other_type", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -114,7 +114,7 @@ func dataMachine() *schema.Resource {
 }
 
 func dataMachineReadByName(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	machineName := d.Get("name").(string)
 	machine, err := client.Machine.GetByName(machineName)
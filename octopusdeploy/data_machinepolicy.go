@@ -29,7 +29,7 @@ func dataMachinePolicy() *schema.Resource {
 }
 
 func dataMachinePolicyReadByName(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	policyName := d.Get("name").(string)
 	policies, err := client.MachinePolicy.GetAll()
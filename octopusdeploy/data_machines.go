@@ -0,0 +1,63 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataMachines() *schema.Resource {
+	dataSchema := getListDataSourceSchema()
+	dataSchema["roles"] = getListDataSourceFilterSchema("Only include machines with any of these roles")
+	dataSchema["environment_ids"] = getListDataSourceFilterSchema("Only include machines in any of these environments")
+	dataSchema["tenant_tags"] = getListDataSourceFilterSchema("Only include machines with any of these tenant tags")
+	dataSchema["statuses"] = getListDataSourceFilterSchema("Only include machines with one of these statuses, e.g. Online, Offline, Unknown, NeedsUpgrade, CalamariNeedsUpgrade or Disabled")
+	dataSchema["communication_styles"] = getListDataSourceFilterSchema("Only include machines of these types, e.g. TentaclePassive, TentacleActive, Ssh, OfflineDrop, AzureWebApp, Kubernetes or None")
+	dataSchema["health_statuses"] = getListDataSourceFilterSchema("Only include machines with one of these health statuses, e.g. Healthy, Unhealthy, HasWarnings, Unavailable or Unknown")
+
+	return &schema.Resource{
+		Read:   dataMachinesRead,
+		Schema: dataSchema,
+	}
+}
+
+func dataMachinesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta).API
+
+	roles := getSliceFromTerraformTypeList(d.Get("roles"))
+	environmentIDs := getSliceFromTerraformTypeList(d.Get("environment_ids"))
+	tenantTags := getSliceFromTerraformTypeList(d.Get("tenant_tags"))
+	statuses := getSliceFromTerraformTypeList(d.Get("statuses"))
+	communicationStyles := getSliceFromTerraformTypeList(d.Get("communication_styles"))
+	healthStatuses := getSliceFromTerraformTypeList(d.Get("health_statuses"))
+
+	machines, err := api.Machine.GetAll()
+
+	if err != nil {
+		return fmt.Errorf("error reading machines: %s", err.Error())
+	}
+
+	result := newListDataSourceResult(d)
+
+	for _, machine := range machines {
+		var communicationStyle string
+		if machine.Endpoint != nil {
+			communicationStyle = machine.Endpoint.CommunicationStyle
+		}
+
+		if !matchesAnyFilter(machine.Roles, roles) ||
+			!matchesAnyFilter(machine.EnvironmentIDs, environmentIDs) ||
+			!matchesAnyFilter(machine.TenantTags, tenantTags) ||
+			!matchesAnyFilter([]string{machine.Status}, statuses) ||
+			!matchesAnyFilter([]string{communicationStyle}, communicationStyles) ||
+			!matchesAnyFilter([]string{machine.HealthStatus}, healthStatuses) {
+			continue
+		}
+
+		result.add(machine.ID, machine.Name)
+	}
+
+	result.set(d)
+
+	return nil
+}
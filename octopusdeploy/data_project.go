@@ -42,7 +42,7 @@ func dataProject() *schema.Resource {
 }
 
 func dataProjectReadByName(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	projectName := d.Get("name")
 
@@ -0,0 +1,46 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataProjects() *schema.Resource {
+	dataSchema := getListDataSourceSchema()
+	dataSchema["project_group_ids"] = getListDataSourceFilterSchema("Only include projects in these project groups")
+	dataSchema["lifecycle_ids"] = getListDataSourceFilterSchema("Only include projects using these lifecycles")
+
+	return &schema.Resource{
+		Read:   dataProjectsRead,
+		Schema: dataSchema,
+	}
+}
+
+func dataProjectsRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).Client
+
+	projectGroupIDs := getSliceFromTerraformTypeList(d.Get("project_group_ids"))
+	lifecycleIDs := getSliceFromTerraformTypeList(d.Get("lifecycle_ids"))
+
+	projects, err := client.Project.GetAll()
+
+	if err != nil {
+		return fmt.Errorf("error reading projects: %s", err.Error())
+	}
+
+	result := newListDataSourceResult(d)
+
+	for _, project := range *projects {
+		if !matchesAnyFilter([]string{project.ProjectGroupID}, projectGroupIDs) ||
+			!matchesAnyFilter([]string{project.LifecycleID}, lifecycleIDs) {
+			continue
+		}
+
+		result.add(project.ID, project.Name)
+	}
+
+	result.set(d)
+
+	return nil
+}
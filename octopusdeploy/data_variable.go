@@ -69,7 +69,12 @@ func tfVariableScopetoODVariableScope(d *schema.ResourceData) *octopusdeploy.Var
 		return nil
 	}
 
-	tfSchemaSet := tfSchemaSetInterface.(*schema.Set)
+	return buildVariableScopeResource(tfSchemaSetInterface)
+}
+
+// buildVariableScopeResource converts the value of a scope block into an OctopusDeploy VariableScope
+func buildVariableScopeResource(tfScope interface{}) *octopusdeploy.VariableScope {
+	tfSchemaSet := tfScope.(*schema.Set)
 	if len(tfSchemaSet.List()) == 0 {
 		return nil
 	}
@@ -90,8 +95,31 @@ func tfVariableScopetoODVariableScope(d *schema.ResourceData) *octopusdeploy.Var
 	return &newScope
 }
 
+// flattenVariableScope converts an OctopusDeploy VariableScope into the value of a scope block
+func flattenVariableScope(scope *octopusdeploy.VariableScope) []interface{} {
+	if scope == nil {
+		return nil
+	}
+
+	if len(scope.Environment) == 0 && len(scope.Action) == 0 && len(scope.Role) == 0 &&
+		len(scope.Channel) == 0 && len(scope.Machine) == 0 && len(scope.TenantTag) == 0 {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"environments": scope.Environment,
+			"actions":      scope.Action,
+			"roles":        scope.Role,
+			"channels":     scope.Channel,
+			"machines":     scope.Machine,
+			"tenant_tags":  scope.TenantTag,
+		},
+	}
+}
+
 func dataVariableReadByName(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	varProject := d.Get("project_id")
 	varName := d.Get("name")
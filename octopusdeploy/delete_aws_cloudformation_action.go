@@ -0,0 +1,42 @@
+package octopusdeploy
+
+import (
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func getDeleteAwsCloudFormationActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addExecutionLocationSchema(element)
+	addWorkerPoolSchema(element)
+	addAwsAccountSchema(element)
+
+	element.Schema["stack_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the CloudFormation stack to delete",
+		Required:    true,
+	}
+
+	element.Schema["wait_for_completion"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Wait for the stack to be deleted before continuing",
+		Optional:    true,
+		Default:     true,
+	}
+
+	return actionSchema
+}
+
+func buildDeleteAwsCloudFormationActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.AwsDeleteCloudFormation"
+
+	resource.Properties = merge(resource.Properties, buildAwsAccountProperties(tfAction))
+
+	resource.Properties["Octopus.Action.Aws.CloudFormationStackName"] = tfAction["stack_name"].(string)
+	resource.Properties["Octopus.Action.Aws.WaitForCompletion"] = formatBool(tfAction["wait_for_completion"].(bool))
+
+	return resource
+}
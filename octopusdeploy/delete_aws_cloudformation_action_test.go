@@ -0,0 +1,69 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployDeleteAwsCloudFormationAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeleteAwsCloudFormationAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeleteAwsCloudFormationAction(),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeleteAwsCloudFormationAction() string {
+	return testAccBuildTestAction(`
+		delete_aws_cloudformation_action {
+			name = "Delete Stack"
+			run_on_server = true
+			region = "us-east-1"
+			use_instance_role = true
+			stack_name = "MyStack"
+			wait_for_completion = false
+		}
+	`)
+}
+
+func testAccCheckDeleteAwsCloudFormationAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.AwsDeleteCloudFormation" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Octopus.Action.Aws.CloudFormationStackName"] != "MyStack" {
+			return fmt.Errorf("CloudFormationStackName is incorrect: %s", action.Properties["Octopus.Action.Aws.CloudFormationStackName"])
+		}
+
+		if action.Properties["Octopus.Action.Aws.WaitForCompletion"] != "False" {
+			return fmt.Errorf("WaitForCompletion is incorrect: %s", action.Properties["Octopus.Action.Aws.WaitForCompletion"])
+		}
+
+		if action.Properties["Octopus.Action.AwsAccount.UseInstanceRole"] != "True" {
+			return fmt.Errorf("UseInstanceRole is incorrect: %s", action.Properties["Octopus.Action.AwsAccount.UseInstanceRole"])
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,159 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type awsCloudFormationParameter struct {
+	ParameterKey   string `json:"ParameterKey"`
+	ParameterValue string `json:"ParameterValue"`
+}
+
+func getDeployAwsCloudFormationActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addExecutionLocationSchema(element)
+	addWorkerPoolSchema(element)
+	addPrimaryPackageSchema(element, false)
+	addAwsAccountSchema(element)
+
+	element.Schema["stack_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the CloudFormation stack",
+		Required:    true,
+	}
+
+	element.Schema["template"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The CloudFormation template, as inline JSON or YAML",
+		Optional:    true,
+	}
+
+	element.Schema["template_file"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The path to the template in the primary package",
+		Optional:    true,
+	}
+
+	element.Schema["parameters_file"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The path to a parameters file in the primary package",
+		Optional:    true,
+	}
+
+	element.Schema["parameters"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Description: "Values for the parameters of an inline template",
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	element.Schema["capabilities"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The capabilities the template requires, e.g. CAPABILITY_IAM, CAPABILITY_NAMED_IAM or CAPABILITY_AUTO_EXPAND",
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validateValueFunc([]string{"CAPABILITY_IAM", "CAPABILITY_NAMED_IAM", "CAPABILITY_AUTO_EXPAND"}),
+		},
+	}
+
+	element.Schema["disable_rollback"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Keep the resources of a stack that failed to create",
+		Optional:    true,
+		Default:     false,
+	}
+
+	element.Schema["wait_for_completion"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Wait for the stack to finish updating before continuing",
+		Optional:    true,
+		Default:     true,
+	}
+
+	element.Schema["tags"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Description: "Tags applied to the stack",
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return actionSchema
+}
+
+// validateDeployAwsCloudFormationAction checks the credentials, and that the template is either inline or
+// from the primary package
+func validateDeployAwsCloudFormationAction(tfAction map[string]interface{}) error {
+	if err := validateAwsAccount(tfAction); err != nil {
+		return err
+	}
+
+	name := tfAction["name"].(string)
+	hasTemplate := tfAction["template"].(string) != ""
+	hasTemplateFile := tfAction["template_file"].(string) != ""
+
+	if hasTemplate == hasTemplateFile {
+		return fmt.Errorf("action %s: exactly one of template and template_file must be set", name)
+	}
+
+	if hasTemplateFile && tfAction["primary_package"].(*schema.Set).Len() == 0 {
+		return fmt.Errorf("action %s: primary_package must be set when template_file is set", name)
+	}
+
+	if hasTemplate && tfAction["parameters_file"].(string) != "" {
+		return fmt.Errorf("action %s: parameters_file can only be used with template_file, use parameters with an inline template", name)
+	}
+
+	return nil
+}
+
+func buildDeployAwsCloudFormationActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.AwsRunCloudFormation"
+
+	resource.Properties = merge(resource.Properties, buildAwsAccountProperties(tfAction))
+
+	resource.Properties["Octopus.Action.Aws.CloudFormationStackName"] = tfAction["stack_name"].(string)
+	resource.Properties["Octopus.Action.Aws.DisableRollback"] = formatBool(tfAction["disable_rollback"].(bool))
+	resource.Properties["Octopus.Action.Aws.WaitForCompletion"] = formatBool(tfAction["wait_for_completion"].(bool))
+
+	if template := tfAction["template"].(string); template != "" {
+		resource.Properties["Octopus.Action.Aws.TemplateSource"] = scriptSourceInline
+		resource.Properties["Octopus.Action.Aws.CloudFormationTemplate"] = template
+
+		/* Parameters are ordered by key, so the property doesn't change between applies */
+		values := buildStringMap(tfAction["parameters"])
+		parameters := []awsCloudFormationParameter{}
+		for _, key := range getSortedKeys(values) {
+			parameters = append(parameters, awsCloudFormationParameter{ParameterKey: key, ParameterValue: values[key]})
+		}
+		j, _ := json.Marshal(parameters)
+		resource.Properties["Octopus.Action.Aws.CloudFormationTemplateParameters"] = string(j)
+	} else {
+		resource.Properties["Octopus.Action.Aws.TemplateSource"] = scriptSourcePackage
+		resource.Properties["Octopus.Action.Aws.CloudFormationTemplate"] = tfAction["template_file"].(string)
+		resource.Properties["Octopus.Action.Aws.CloudFormationTemplateParameters"] = tfAction["parameters_file"].(string)
+	}
+
+	capabilities := getSliceFromTerraformTypeList(tfAction["capabilities"])
+	if capabilities == nil {
+		capabilities = []string{}
+	}
+	j, _ := json.Marshal(capabilities)
+	resource.Properties["Octopus.Action.Aws.IamCapabilities"] = string(j)
+
+	j, _ = json.Marshal(buildAwsKeyValues(tfAction["tags"]))
+	resource.Properties["Octopus.Action.Aws.CloudFormation.Tags"] = string(j)
+
+	return resource
+}
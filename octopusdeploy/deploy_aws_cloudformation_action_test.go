@@ -0,0 +1,146 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployDeployAwsCloudFormationAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeployAwsCloudFormationAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeployAwsCloudFormationAction(),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployDeployAwsCloudFormationActionBothTemplates(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBuildTestAction(`
+					deploy_aws_cloudformation_action {
+						name = "Deploy Stack"
+						run_on_server = true
+						region = "us-east-1"
+						use_instance_role = true
+						stack_name = "MyStack"
+						template = "{}"
+						template_file = "template.json"
+					}
+				`),
+				ExpectError: regexp.MustCompile("exactly one of template and template_file must be set"),
+			},
+		},
+	})
+}
+
+func testAccDeployAwsCloudFormationAction() string {
+	return testAccBuildTestAction(`
+		deploy_aws_cloudformation_action {
+			name = "Deploy Stack"
+			run_on_server = true
+			region = "us-east-1"
+			aws_account_variable = "AWS Account"
+			stack_name = "MyStack"
+			capabilities = ["CAPABILITY_IAM"]
+
+			template = <<EOT
+{
+  "Parameters": {
+    "BucketName": { "Type": "String" },
+    "AccessControl": { "Type": "String" }
+  },
+  "Resources": {
+    "Bucket": {
+      "Type": "AWS::S3::Bucket",
+      "Properties": {
+        "BucketName": { "Ref": "BucketName" },
+        "AccessControl": { "Ref": "AccessControl" }
+      }
+    }
+  }
+}
+EOT
+
+			parameters = {
+				BucketName = "my-bucket"
+				AccessControl = "Private"
+			}
+
+			tags = {
+				team = "web"
+				environment = "production"
+			}
+
+			assume_role {
+				role_arn = "arn:aws:iam::123456789012:role/Deployer"
+				session_duration = 900
+			}
+		}
+	`)
+}
+
+func testAccCheckDeployAwsCloudFormationAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.AwsRunCloudFormation" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Octopus.Action.Aws.CloudFormationStackName"] != "MyStack" {
+			return fmt.Errorf("CloudFormationStackName is incorrect: %s", action.Properties["Octopus.Action.Aws.CloudFormationStackName"])
+		}
+
+		if action.Properties["Octopus.Action.Aws.TemplateSource"] != "Inline" {
+			return fmt.Errorf("TemplateSource is incorrect: %s", action.Properties["Octopus.Action.Aws.TemplateSource"])
+		}
+
+		if action.Properties["Octopus.Action.Aws.CloudFormationTemplateParameters"] != `[{"ParameterKey":"AccessControl","ParameterValue":"Private"},{"ParameterKey":"BucketName","ParameterValue":"my-bucket"}]` {
+			return fmt.Errorf("CloudFormationTemplateParameters is incorrect: %s", action.Properties["Octopus.Action.Aws.CloudFormationTemplateParameters"])
+		}
+
+		if action.Properties["Octopus.Action.Aws.CloudFormation.Tags"] != `[{"key":"environment","value":"production"},{"key":"team","value":"web"}]` {
+			return fmt.Errorf("CloudFormation.Tags is incorrect: %s", action.Properties["Octopus.Action.Aws.CloudFormation.Tags"])
+		}
+
+		if action.Properties["Octopus.Action.Aws.IamCapabilities"] != `["CAPABILITY_IAM"]` {
+			return fmt.Errorf("IamCapabilities is incorrect: %s", action.Properties["Octopus.Action.Aws.IamCapabilities"])
+		}
+
+		if action.Properties["Octopus.Action.AwsAccount.Variable"] != "AWS Account" {
+			return fmt.Errorf("AwsAccount.Variable is incorrect: %s", action.Properties["Octopus.Action.AwsAccount.Variable"])
+		}
+
+		if action.Properties["Octopus.Action.Aws.AssumedRoleArn"] != "arn:aws:iam::123456789012:role/Deployer" {
+			return fmt.Errorf("AssumedRoleArn is incorrect: %s", action.Properties["Octopus.Action.Aws.AssumedRoleArn"])
+		}
+
+		if action.Properties["Octopus.Action.Aws.AssumeRoleSessionDurationSeconds"] != "900" {
+			return fmt.Errorf("AssumeRoleSessionDurationSeconds is incorrect: %s", action.Properties["Octopus.Action.Aws.AssumeRoleSessionDurationSeconds"])
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,114 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/config/hcl2shim"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func getDeployAzureResourceGroupActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addExecutionLocationSchema(element)
+	addWorkerPoolSchema(element)
+	addPrimaryPackageSchema(element, false)
+	addAzureAccountSchema(element)
+
+	element.Schema["resource_group_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The resource group to deploy the template to",
+		Required:    true,
+	}
+
+	element.Schema["deployment_mode"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Whether resources not in the template are kept ('Incremental') or deleted ('Complete')",
+		Optional:     true,
+		Default:      "Incremental",
+		ValidateFunc: validateValueFunc([]string{"Incremental", "Complete"}),
+	}
+
+	element.Schema["template"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The ARM template, as inline JSON",
+		Optional:    true,
+	}
+
+	element.Schema["template_file"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The path to the template in the primary package",
+		Optional:    true,
+	}
+
+	element.Schema["parameters"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The parameters of an inline template, as the JSON 'parameters' object of an ARM parameters file",
+		Optional:    true,
+	}
+
+	element.Schema["parameters_file"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The path to a parameters file in the primary package",
+		Optional:    true,
+	}
+
+	return actionSchema
+}
+
+// validateDeployAzureResourceGroupAction checks that the template is either inline or from the primary
+// package, and that inline parameters are JSON
+func validateDeployAzureResourceGroupAction(tfAction map[string]interface{}) error {
+	name := tfAction["name"].(string)
+	hasTemplate := tfAction["template"].(string) != ""
+	hasTemplateFile := tfAction["template_file"].(string) != ""
+
+	if hasTemplate == hasTemplateFile {
+		return fmt.Errorf("action %s: exactly one of template and template_file must be set", name)
+	}
+
+	if hasTemplateFile && tfAction["primary_package"].(*schema.Set).Len() == 0 {
+		return fmt.Errorf("action %s: primary_package must be set when template_file is set", name)
+	}
+
+	if hasTemplate && tfAction["parameters_file"].(string) != "" {
+		return fmt.Errorf("action %s: parameters_file can only be used with template_file, use parameters with an inline template", name)
+	}
+
+	if hasTemplateFile && tfAction["parameters"].(string) != "" {
+		return fmt.Errorf("action %s: parameters can only be used with an inline template, use parameters_file with template_file", name)
+	}
+
+	/* Parameters built from values computed during apply, e.g. with jsonencode, are checked by Octopus */
+	parameters := tfAction["parameters"].(string)
+	if parameters != "" && parameters != hcl2shim.UnknownVariableValue && !json.Valid([]byte(parameters)) {
+		return fmt.Errorf("action %s: parameters must be valid JSON", name)
+	}
+
+	return nil
+}
+
+func buildDeployAzureResourceGroupActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.AzureResourceGroup"
+
+	resource.Properties = merge(resource.Properties, buildAzureAccountProperties(tfAction))
+
+	resource.Properties["Octopus.Action.Azure.ResourceGroupName"] = tfAction["resource_group_name"].(string)
+	resource.Properties["Octopus.Action.Azure.ResourceGroupDeploymentMode"] = tfAction["deployment_mode"].(string)
+
+	if template := tfAction["template"].(string); template != "" {
+		resource.Properties["Octopus.Action.Azure.TemplateSource"] = scriptSourceInline
+		resource.Properties["Octopus.Action.Azure.ResourceGroupTemplate"] = template
+		resource.Properties["Octopus.Action.Azure.ResourceGroupTemplateParameters"] = tfAction["parameters"].(string)
+	} else {
+		resource.Properties["Octopus.Action.Azure.TemplateSource"] = scriptSourcePackage
+		resource.Properties["Octopus.Action.Azure.ResourceGroupTemplate"] = tfAction["template_file"].(string)
+		resource.Properties["Octopus.Action.Azure.ResourceGroupTemplateParameters"] = tfAction["parameters_file"].(string)
+	}
+
+	return resource
+}
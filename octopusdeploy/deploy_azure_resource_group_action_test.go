@@ -0,0 +1,151 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployDeployAzureResourceGroupAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeployAzureResourceGroupAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeployAzureResourceGroupAction(),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeployAzureResourceGroupAction() string {
+	return testAccAzureAccount + testAccBuildTestAction(`
+		deploy_azure_resource_group_action {
+			name = "Deploy Template"
+			run_on_server = true
+			azure_account_id = "${octopusdeploy_account.azure.id}"
+			resource_group_name = "my-resource-group"
+			deployment_mode = "Complete"
+
+			primary_package {
+				package_id = "MyTemplates"
+			}
+
+			template_file = "azuredeploy.json"
+			parameters_file = "azuredeploy.parameters.json"
+		}
+	`)
+}
+
+func testAccCheckDeployAzureResourceGroupAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.AzureResourceGroup" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Octopus.Action.Azure.ResourceGroupDeploymentMode"] != "Complete" {
+			return fmt.Errorf("ResourceGroupDeploymentMode is incorrect: %s", action.Properties["Octopus.Action.Azure.ResourceGroupDeploymentMode"])
+		}
+
+		if action.Properties["Octopus.Action.Azure.TemplateSource"] != "Package" {
+			return fmt.Errorf("TemplateSource is incorrect: %s", action.Properties["Octopus.Action.Azure.TemplateSource"])
+		}
+
+		if action.Properties["Octopus.Action.Azure.ResourceGroupTemplate"] != "azuredeploy.json" {
+			return fmt.Errorf("ResourceGroupTemplate is incorrect: %s", action.Properties["Octopus.Action.Azure.ResourceGroupTemplate"])
+		}
+
+		if action.Properties["Octopus.Action.Azure.ResourceGroupTemplateParameters"] != "azuredeploy.parameters.json" {
+			return fmt.Errorf("ResourceGroupTemplateParameters is incorrect: %s", action.Properties["Octopus.Action.Azure.ResourceGroupTemplateParameters"])
+		}
+
+		return nil
+	}
+}
+
+func TestAccOctopusDeployDeployAzureResourceGroupActionInterpolatedParameters(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeployAzureResourceGroupActionInterpolatedParameters(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeployAzureResourceGroupActionInterpolatedParameters(),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeployAzureResourceGroupActionInterpolatedParameters() string {
+	return testAccAzureAccount + testAccBuildTestAction(`
+		deploy_azure_resource_group_action {
+			name = "Deploy Template"
+			run_on_server = true
+			azure_account_id = "${octopusdeploy_account.azure.id}"
+			resource_group_name = "my-resource-group"
+
+			template = jsonencode({
+				contentVersion = "1.0.0.0"
+				parameters = {
+					accountId = {
+						type = "string"
+					}
+				}
+				resources = []
+			})
+
+			parameters = jsonencode({
+				accountId = {
+					value = octopusdeploy_account.azure.id
+				}
+			})
+		}
+	`)
+}
+
+func testAccCheckDeployAzureResourceGroupActionInterpolatedParameters() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.Properties["Octopus.Action.Azure.TemplateSource"] != "Inline" {
+			return fmt.Errorf("TemplateSource is incorrect: %s", action.Properties["Octopus.Action.Azure.TemplateSource"])
+		}
+
+		var parameters map[string]map[string]string
+		if err := json.Unmarshal([]byte(action.Properties["Octopus.Action.Azure.ResourceGroupTemplateParameters"]), &parameters); err != nil {
+			return fmt.Errorf("ResourceGroupTemplateParameters is not valid JSON: %s", err.Error())
+		}
+
+		if parameters["accountId"]["value"] == "" {
+			return fmt.Errorf("ResourceGroupTemplateParameters is missing the account ID: %s", action.Properties["Octopus.Action.Azure.ResourceGroupTemplateParameters"])
+		}
+
+		return nil
+	}
+}
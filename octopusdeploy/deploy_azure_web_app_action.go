@@ -0,0 +1,95 @@
+package octopusdeploy
+
+import (
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func getDeployAzureWebAppActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addExecutionLocationSchema(element)
+	addWorkerPoolSchema(element)
+	addPrimaryPackageSchema(element, true)
+	addAzureAccountSchema(element)
+	addCustomDeploymentScriptsFeature(element)
+	addStructuredConfigurationVariablesFeature(element)
+	addConfigurationVariablesFeature(element)
+	addConfigurationTransformsFeature(element)
+	addSubstituteVariablesInFilesFeature(element)
+
+	element.Schema["web_app_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the web app",
+		Required:    true,
+	}
+
+	element.Schema["resource_group_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The resource group of the web app",
+		Required:    true,
+	}
+
+	element.Schema["deployment_slot"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The deployment slot to deploy to. Defaults to the production slot",
+		Optional:    true,
+	}
+
+	element.Schema["physical_path"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The path relative to the site root to deploy to",
+		Optional:    true,
+	}
+
+	element.Schema["remove_additional_files"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Delete files on the web app that are not in the package",
+		Optional:    true,
+		Default:     false,
+	}
+
+	element.Schema["preserve_app_data"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Keep the App_Data folder when removing additional files",
+		Optional:    true,
+		Default:     false,
+	}
+
+	element.Schema["app_offline"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Take the app offline with app_offline.htm while deploying",
+		Optional:    true,
+		Default:     false,
+	}
+
+	element.Schema["use_checksum"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Compare files by checksum instead of timestamp",
+		Optional:    true,
+		Default:     false,
+	}
+
+	return actionSchema
+}
+
+func buildDeployAzureWebAppActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.AzureWebApp"
+
+	resource.Properties = merge(resource.Properties, buildAzureAccountProperties(tfAction))
+
+	resource.Properties["Octopus.Action.Azure.WebAppName"] = tfAction["web_app_name"].(string)
+	resource.Properties["Octopus.Action.Azure.ResourceGroupName"] = tfAction["resource_group_name"].(string)
+	resource.Properties["Octopus.Action.Azure.DeploymentSlot"] = tfAction["deployment_slot"].(string)
+	resource.Properties["Octopus.Action.Azure.PhysicalPath"] = tfAction["physical_path"].(string)
+	resource.Properties["Octopus.Action.Azure.RemoveAdditionalFiles"] = formatBool(tfAction["remove_additional_files"].(bool))
+	resource.Properties["Octopus.Action.Azure.PreserveAppData"] = formatBool(tfAction["preserve_app_data"].(bool))
+	resource.Properties["Octopus.Action.Azure.AppOffline"] = formatBool(tfAction["app_offline"].(bool))
+	resource.Properties["Octopus.Action.Azure.UseChecksum"] = formatBool(tfAction["use_checksum"].(bool))
+
+	addPackageFeaturesToActionResource(tfAction, resource)
+
+	return resource
+}
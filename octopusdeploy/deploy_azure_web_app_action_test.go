@@ -0,0 +1,120 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// testAccAzureAccount is an Azure service principal account the Azure action tests deploy with
+const testAccAzureAccount = `
+		resource "octopusdeploy_account" "azure" {
+			name            = "Test Azure Account"
+			account_type    = "AzureServicePrincipal"
+			client_id       = "00000000-0000-0000-0000-000000000001"
+			tenant_id       = "00000000-0000-0000-0000-000000000002"
+			subscription_id = "00000000-0000-0000-0000-000000000003"
+			client_secret   = "secret"
+		}
+`
+
+func TestAccOctopusDeployDeployAzureWebAppAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeployAzureWebAppAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeployAzureWebAppAction(),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployDeployAzureWebAppActionMissingAccount(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBuildTestAction(`
+					deploy_azure_web_app_action {
+						name = "Deploy Web App"
+						run_on_server = true
+						azure_account_id = "Accounts-999999"
+						web_app_name = "my-web-app"
+						resource_group_name = "my-resource-group"
+
+						primary_package {
+							package_id = "MyWebApp"
+						}
+					}
+				`),
+				ExpectError: regexp.MustCompile("account Accounts-999999 does not exist"),
+			},
+		},
+	})
+}
+
+func testAccDeployAzureWebAppAction() string {
+	return testAccAzureAccount + testAccBuildTestAction(`
+		deploy_azure_web_app_action {
+			name = "Deploy Web App"
+			run_on_server = true
+			azure_account_id = "${octopusdeploy_account.azure.id}"
+			web_app_name = "my-web-app"
+			resource_group_name = "my-resource-group"
+			deployment_slot = "staging"
+			physical_path = "site\\wwwroot"
+
+			primary_package {
+				package_id = "MyWebApp"
+			}
+
+			configuration_transforms {
+				additional_transforms = "Web.Cloud.config => Web.config"
+			}
+		}
+	`)
+}
+
+func testAccCheckDeployAzureWebAppAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.AzureWebApp" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Octopus.Action.Azure.DeploymentSlot"] != "staging" {
+			return fmt.Errorf("DeploymentSlot is incorrect: %s", action.Properties["Octopus.Action.Azure.DeploymentSlot"])
+		}
+
+		if action.Properties["Octopus.Action.Azure.PhysicalPath"] != "site\\wwwroot" {
+			return fmt.Errorf("PhysicalPath is incorrect: %s", action.Properties["Octopus.Action.Azure.PhysicalPath"])
+		}
+
+		if action.Properties["Octopus.Action.Package.AdditionalXmlConfigurationTransforms"] != "Web.Cloud.config => Web.config" {
+			return fmt.Errorf("AdditionalXmlConfigurationTransforms is incorrect: %s", action.Properties["Octopus.Action.Package.AdditionalXmlConfigurationTransforms"])
+		}
+
+		if !isFeatureEnabled(action.Properties, featureConfigurationTransforms) {
+			return fmt.Errorf("Configuration transforms are not enabled: %s", action.Properties[enabledFeaturesProperty])
+		}
+
+		return nil
+	}
+}
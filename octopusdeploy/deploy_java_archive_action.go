@@ -0,0 +1,45 @@
+package octopusdeploy
+
+import (
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func getDeployJavaArchiveActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addPackagesSchema(element, true)
+	element.Schema["primary_package"].Description = "The JAR, WAR, EAR or RAR file to deploy"
+	addCustomInstallationDirectoryFeature(element)
+	addCustomDeploymentScriptsFeature(element)
+	addStructuredConfigurationVariablesFeature(element)
+	addSubstituteVariablesInFilesFeature(element)
+
+	element.Schema["deploy_exploded"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Extract the archive instead of copying it",
+		Optional:    true,
+		Default:     false,
+	}
+
+	element.Schema["package_file_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The file name to copy the archive to. Defaults to the package file name",
+		Optional:    true,
+	}
+
+	return actionSchema
+}
+
+func buildDeployJavaArchiveActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.JavaArchive"
+
+	resource.Properties["Octopus.Action.JavaArchive.DeployExploded"] = formatBool(tfAction["deploy_exploded"].(bool))
+	resource.Properties["Octopus.Action.Package.CustomPackageFileName"] = tfAction["package_file_name"].(string)
+
+	addPackageFeaturesToActionResource(tfAction, resource)
+
+	return resource
+}
@@ -0,0 +1,69 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployDeployJavaArchiveAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeployJavaArchiveAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeployJavaArchiveAction(),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeployJavaArchiveAction() string {
+	return testAccBuildTestAction(`
+		deploy_java_archive_action {
+			name = "Deploy JAR"
+			deploy_exploded = true
+
+			primary_package {
+				package_id = "MyService"
+			}
+
+			custom_installation_directory {
+				directory = "/opt/my-service"
+			}
+		}
+	`)
+}
+
+func testAccCheckDeployJavaArchiveAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.JavaArchive" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Octopus.Action.JavaArchive.DeployExploded"] != "True" {
+			return fmt.Errorf("DeployExploded is incorrect: %s", action.Properties["Octopus.Action.JavaArchive.DeployExploded"])
+		}
+
+		if action.Properties["Octopus.Action.Package.CustomInstallationDirectory"] != "/opt/my-service" {
+			return fmt.Errorf("CustomInstallationDirectory is incorrect: %s", action.Properties["Octopus.Action.Package.CustomInstallationDirectory"])
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,61 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func getDeployKubernetesConfigMapActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addExecutionLocationSchema(element)
+	element.Schema["config_map_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the config map resource",
+		Required:    true,
+	}
+
+	element.Schema["config_map_values"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+
+	return actionSchema
+}
+
+func buildDeployKubernetesConfigMapActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.KubernetesDeployConfigMap"
+
+	resource.Properties["Octopus.Action.KubernetesContainers.ConfigMapName"] = tfAction["config_map_name"].(string)
+
+	if tfConfigMapValues, ok := tfAction["config_map_values"]; ok {
+
+		configMapValues := make(map[string]string)
+
+		for _, tfConfigMapValue := range tfConfigMapValues.([]interface{}) {
+			tfConfigMapValueTyped := tfConfigMapValue.(map[string]interface{})
+			configMapValues[tfConfigMapValueTyped["key"].(string)] = tfConfigMapValueTyped["value"].(string)
+		}
+
+		j, _ := json.Marshal(configMapValues)
+
+		resource.Properties["Octopus.Action.KubernetesContainers.ConfigMapValues"] = string(j)
+	}
+
+	return resource
+}
@@ -0,0 +1,73 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployDeployKubernetesConfigMapAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeployKubernetesConfigMapAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeployKubernetesConfigMapAction(),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeployKubernetesConfigMapAction() string {
+	return testAccBuildTestAction(`
+		deploy_kubernetes_config_map_action {
+            name = "Deploy Config Map"
+            run_on_server = true
+
+			config_map_name = "web-config"
+
+			config_map_values {
+				key = "key"
+				value = "value"
+			}
+
+			config_map_values {
+				key = "key1"
+				value = "value1"
+			}
+        }
+	`)
+}
+
+func testAccCheckDeployKubernetesConfigMapAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.KubernetesDeployConfigMap" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Octopus.Action.KubernetesContainers.ConfigMapName"] != "web-config" {
+			return fmt.Errorf("ConfigMapName is incorrect: %s", action.Properties["Octopus.Action.KubernetesContainers.ConfigMapName"])
+		}
+
+		if action.Properties["Octopus.Action.KubernetesContainers.ConfigMapValues"] != `{"key":"value","key1":"value1"}` {
+			return fmt.Errorf("ConfigMapValues is incorrect: %s", action.Properties["Octopus.Action.KubernetesContainers.ConfigMapValues"])
+		}
+
+		return nil
+	}
+}
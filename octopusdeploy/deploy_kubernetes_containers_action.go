@@ -0,0 +1,742 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The types below mirror the JSON Octopus stores in the Octopus.Action.KubernetesContainers.* properties
+
+type kubernetesKeyValue struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Option string `json:"option,omitempty"`
+}
+
+type kubernetesResourceValues struct {
+	Memory string `json:"memory"`
+	CPU    string `json:"cpu"`
+}
+
+type kubernetesResources struct {
+	Requests kubernetesResourceValues `json:"requests"`
+	Limits   kubernetesResourceValues `json:"limits"`
+}
+
+type kubernetesProbeExec struct {
+	Command []string `json:"command"`
+}
+
+type kubernetesProbeHTTPGet struct {
+	Host        string               `json:"host"`
+	Path        string               `json:"path"`
+	Port        string               `json:"port"`
+	Scheme      string               `json:"scheme"`
+	HTTPHeaders []kubernetesKeyValue `json:"httpHeaders"`
+}
+
+type kubernetesProbeTCPSocket struct {
+	Host string `json:"host"`
+	Port string `json:"port"`
+}
+
+type kubernetesProbe struct {
+	Type                string                   `json:"type"`
+	FailureThreshold    string                   `json:"failureThreshold"`
+	InitialDelaySeconds string                   `json:"initialDelaySeconds"`
+	PeriodSeconds       string                   `json:"periodSeconds"`
+	SuccessThreshold    string                   `json:"successThreshold"`
+	TimeoutSeconds      string                   `json:"timeoutSeconds"`
+	Exec                kubernetesProbeExec      `json:"exec"`
+	HTTPGet             kubernetesProbeHTTPGet   `json:"httpGet"`
+	TCPSocket           kubernetesProbeTCPSocket `json:"tcpSocket"`
+}
+
+type kubernetesContainer struct {
+	Name                 string               `json:"Name"`
+	Ports                []kubernetesKeyValue `json:"Ports"`
+	EnvironmentVariables []kubernetesKeyValue `json:"EnvironmentVariables"`
+	VolumeMounts         []kubernetesKeyValue `json:"VolumeMounts"`
+	Resources            kubernetesResources  `json:"Resources"`
+	LivenessProbe        *kubernetesProbe     `json:"LivenessProbe,omitempty"`
+	ReadinessProbe       *kubernetesProbe     `json:"ReadinessProbe,omitempty"`
+	Command              []string             `json:"Command"`
+	Args                 []string             `json:"Args"`
+}
+
+type kubernetesVolume struct {
+	Name          string `json:"Name"`
+	Type          string `json:"Type"`
+	ReferenceName string `json:"ReferenceName"`
+	LocalPath     string `json:"LocalPath"`
+}
+
+type kubernetesServicePort struct {
+	Name       string `json:"name"`
+	Port       string `json:"port"`
+	TargetPort string `json:"targetPort"`
+	NodePort   string `json:"nodePort"`
+	Protocol   string `json:"protocol"`
+}
+
+type kubernetesIngressPath struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type kubernetesIngressRule struct {
+	Host string `json:"host"`
+	HTTP struct {
+		Paths []kubernetesIngressPath `json:"paths"`
+	} `json:"http"`
+}
+
+func getDeployKubernetesContainersActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addExecutionLocationSchema(element)
+	addWorkerPoolSchema(element)
+
+	element.Schema["deployment_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the Kubernetes deployment resource",
+		Required:    true,
+	}
+
+	element.Schema["namespace"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The namespace to deploy to. Defaults to the namespace of the target",
+		Optional:    true,
+	}
+
+	element.Schema["replicas"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "The number of pods to run",
+		Optional:    true,
+		Default:     1,
+	}
+
+	element.Schema["deployment_strategy"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "How pods are replaced, one of 'RollingUpdate', 'Recreate' or 'BlueGreen'",
+		Optional:     true,
+		Default:      "RollingUpdate",
+		ValidateFunc: validateValueFunc([]string{"RollingUpdate", "Recreate", "BlueGreen"}),
+	}
+
+	element.Schema["wait_for_deployment"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Wait for the deployment to succeed before continuing",
+		Optional:    true,
+		Default:     false,
+	}
+
+	element.Schema["deployment_labels"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Description: "Labels applied to the deployment and its pods",
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	element.Schema["container"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The containers of the pod",
+		Required:    true,
+		MinItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Description: "The name of the container",
+					Required:    true,
+				},
+				"package_id": {
+					Type:        schema.TypeString,
+					Description: "The image of the container",
+					Required:    true,
+				},
+				"feed_id": {
+					Type:        schema.TypeString,
+					Description: "The container registry feed the image is pulled from",
+					Required:    true,
+				},
+				"port": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"container_port": {
+								Type:     schema.TypeInt,
+								Required: true,
+							},
+							"protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "TCP",
+								ValidateFunc: validateValueFunc([]string{"TCP", "UDP"}),
+							},
+						},
+					},
+				},
+				"env": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"volume_mount": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"volume_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"mount_path": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"sub_path": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"resources": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"cpu_request": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"memory_request": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"cpu_limit": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"memory_limit": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"liveness_probe":  getKubernetesProbeSchema("Restart the container when this probe fails"),
+				"readiness_probe": getKubernetesProbeSchema("Only send traffic to the container when this probe succeeds"),
+				"command": {
+					Type:        schema.TypeList,
+					Description: "Overrides the entrypoint of the image",
+					Optional:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"args": {
+					Type:        schema.TypeList,
+					Description: "Arguments passed to the entrypoint",
+					Optional:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+
+	element.Schema["volume"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Volumes that can be mounted by the containers",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateValueFunc([]string{"ConfigMap", "Secret", "EmptyDir", "HostPath", "PersistentVolumeClaim"}),
+				},
+				"reference_name": {
+					Type:        schema.TypeString,
+					Description: "The name of the config map, secret or persistent volume claim",
+					Optional:    true,
+				},
+				"host_path": {
+					Type:        schema.TypeString,
+					Description: "The path on the node for HostPath volumes",
+					Optional:    true,
+				},
+			},
+		},
+	}
+
+	element.Schema["service"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "A service exposing the pods",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "ClusterIP",
+					ValidateFunc: validateValueFunc([]string{"ClusterIP", "NodePort", "LoadBalancer"}),
+				},
+				"cluster_ip": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"port": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"port": {
+								Type:     schema.TypeInt,
+								Required: true,
+							},
+							"target_port": {
+								Type:        schema.TypeString,
+								Description: "The container port name or number traffic is sent to",
+								Optional:    true,
+							},
+							"node_port": {
+								Type:     schema.TypeInt,
+								Optional: true,
+							},
+							"protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "TCP",
+								ValidateFunc: validateValueFunc([]string{"TCP", "UDP"}),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	element.Schema["ingress"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "An ingress routing traffic to the service",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"class_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"annotations": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"rule": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"host": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"path": {
+								Type:     schema.TypeList,
+								Required: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"path": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"service_port": {
+											Type:        schema.TypeString,
+											Description: "The service port name or number",
+											Required:    true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	element.Schema["config_map"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "A config map deployed with the pods",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"values": {
+					Type:     schema.TypeMap,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+
+	return actionSchema
+}
+
+func getKubernetesProbeSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:         schema.TypeString,
+					Description:  "One of 'Command', 'HttpGet' or 'TcpSocket'",
+					Required:     true,
+					ValidateFunc: validateValueFunc([]string{"Command", "HttpGet", "TcpSocket"}),
+				},
+				"command": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"host": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"path": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"port": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"scheme": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"initial_delay_seconds": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"period_seconds": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"timeout_seconds": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"success_threshold": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"failure_threshold": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func buildDeployKubernetesContainersActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.KubernetesDeployContainers"
+
+	resource.Properties["Octopus.Action.KubernetesContainers.DeploymentName"] = tfAction["deployment_name"].(string)
+	resource.Properties["Octopus.Action.KubernetesContainers.Namespace"] = tfAction["namespace"].(string)
+	resource.Properties["Octopus.Action.KubernetesContainers.Replicas"] = strconv.Itoa(tfAction["replicas"].(int))
+	resource.Properties["Octopus.Action.KubernetesContainers.DeploymentStyle"] = tfAction["deployment_strategy"].(string)
+	resource.Properties["Octopus.Action.KubernetesContainers.DeploymentResourceType"] = "Deployment"
+
+	if tfAction["wait_for_deployment"].(bool) {
+		resource.Properties["Octopus.Action.KubernetesContainers.DeploymentWait"] = "Wait"
+	} else {
+		resource.Properties["Octopus.Action.KubernetesContainers.DeploymentWait"] = "NoWait"
+	}
+
+	resource.Properties["Octopus.Action.KubernetesContainers.DeploymentLabels"] = marshalKubernetesProperty(buildStringMap(tfAction["deployment_labels"]))
+
+	var containers []kubernetesContainer
+	for _, tfContainer := range tfAction["container"].([]interface{}) {
+		tfContainerMap := tfContainer.(map[string]interface{})
+		containers = append(containers, buildKubernetesContainer(tfContainerMap))
+
+		// The image of each container is a package reference with the same name as the container
+		resource.Packages = append(resource.Packages, octopusdeploy.PackageReference{
+			Name:                tfContainerMap["name"].(string),
+			PackageId:           tfContainerMap["package_id"].(string),
+			FeedId:              tfContainerMap["feed_id"].(string),
+			AcquisitionLocation: (string)(octopusdeploy.PackageAcquisitionLocation_NotAcquired),
+			Properties:          map[string]string{},
+		})
+	}
+	resource.Properties["Octopus.Action.KubernetesContainers.Containers"] = marshalKubernetesProperty(containers)
+
+	volumes := []kubernetesVolume{}
+	for _, tfVolume := range tfAction["volume"].([]interface{}) {
+		tfVolumeMap := tfVolume.(map[string]interface{})
+		volumes = append(volumes, kubernetesVolume{
+			Name:          tfVolumeMap["name"].(string),
+			Type:          tfVolumeMap["type"].(string),
+			ReferenceName: tfVolumeMap["reference_name"].(string),
+			LocalPath:     tfVolumeMap["host_path"].(string),
+		})
+	}
+	resource.Properties["Octopus.Action.KubernetesContainers.CombinedVolumes"] = marshalKubernetesProperty(volumes)
+
+	if tfService := getSingleBlock(tfAction["service"]); tfService != nil {
+		resource.Properties["Octopus.Action.KubernetesContainers.ServiceName"] = tfService["name"].(string)
+		resource.Properties["Octopus.Action.KubernetesContainers.ServiceType"] = tfService["type"].(string)
+		resource.Properties["Octopus.Action.KubernetesContainers.ServiceClusterIp"] = tfService["cluster_ip"].(string)
+
+		ports := []kubernetesServicePort{}
+		for _, tfPort := range tfService["port"].([]interface{}) {
+			tfPortMap := tfPort.(map[string]interface{})
+			port := kubernetesServicePort{
+				Name:       tfPortMap["name"].(string),
+				Port:       strconv.Itoa(tfPortMap["port"].(int)),
+				TargetPort: tfPortMap["target_port"].(string),
+				NodePort:   formatOptionalInt(tfPortMap["node_port"].(int)),
+				Protocol:   tfPortMap["protocol"].(string),
+			}
+			ports = append(ports, port)
+		}
+		resource.Properties["Octopus.Action.KubernetesContainers.ServicePorts"] = marshalKubernetesProperty(ports)
+	}
+
+	if tfIngress := getSingleBlock(tfAction["ingress"]); tfIngress != nil {
+		resource.Properties["Octopus.Action.KubernetesContainers.IngressName"] = tfIngress["name"].(string)
+		resource.Properties["Octopus.Action.KubernetesContainers.IngressClassName"] = tfIngress["class_name"].(string)
+
+		resource.Properties["Octopus.Action.KubernetesContainers.IngressAnnotations"] = marshalKubernetesProperty(buildKubernetesKeyValues(tfIngress["annotations"]))
+
+		rules := []kubernetesIngressRule{}
+		for _, tfRule := range tfIngress["rule"].([]interface{}) {
+			tfRuleMap := tfRule.(map[string]interface{})
+			rule := kubernetesIngressRule{Host: tfRuleMap["host"].(string)}
+			rule.HTTP.Paths = []kubernetesIngressPath{}
+			for _, tfPath := range tfRuleMap["path"].([]interface{}) {
+				tfPathMap := tfPath.(map[string]interface{})
+				rule.HTTP.Paths = append(rule.HTTP.Paths, kubernetesIngressPath{
+					Key:   tfPathMap["path"].(string),
+					Value: tfPathMap["service_port"].(string),
+				})
+			}
+			rules = append(rules, rule)
+		}
+		resource.Properties["Octopus.Action.KubernetesContainers.IngressRules"] = marshalKubernetesProperty(rules)
+	}
+
+	if tfConfigMap := getSingleBlock(tfAction["config_map"]); tfConfigMap != nil {
+		resource.Properties["Octopus.Action.KubernetesContainers.ConfigMapName"] = tfConfigMap["name"].(string)
+		resource.Properties["Octopus.Action.KubernetesContainers.ConfigMapValues"] = marshalKubernetesProperty(buildStringMap(tfConfigMap["values"]))
+	}
+
+	return resource
+}
+
+func buildKubernetesContainer(tfContainer map[string]interface{}) kubernetesContainer {
+	container := kubernetesContainer{
+		Name:                 tfContainer["name"].(string),
+		Ports:                []kubernetesKeyValue{},
+		EnvironmentVariables: []kubernetesKeyValue{},
+		VolumeMounts:         []kubernetesKeyValue{},
+		Command:              getSliceFromTerraformTypeList(tfContainer["command"]),
+		Args:                 getSliceFromTerraformTypeList(tfContainer["args"]),
+		LivenessProbe:        buildKubernetesProbe(tfContainer["liveness_probe"]),
+		ReadinessProbe:       buildKubernetesProbe(tfContainer["readiness_probe"]),
+	}
+
+	if container.Command == nil {
+		container.Command = []string{}
+	}
+
+	if container.Args == nil {
+		container.Args = []string{}
+	}
+
+	for _, tfPort := range tfContainer["port"].([]interface{}) {
+		tfPortMap := tfPort.(map[string]interface{})
+		container.Ports = append(container.Ports, kubernetesKeyValue{
+			Key:    tfPortMap["name"].(string),
+			Value:  strconv.Itoa(tfPortMap["container_port"].(int)),
+			Option: tfPortMap["protocol"].(string),
+		})
+	}
+
+	for _, tfEnv := range tfContainer["env"].([]interface{}) {
+		tfEnvMap := tfEnv.(map[string]interface{})
+		container.EnvironmentVariables = append(container.EnvironmentVariables, kubernetesKeyValue{
+			Key:   tfEnvMap["name"].(string),
+			Value: tfEnvMap["value"].(string),
+		})
+	}
+
+	for _, tfMount := range tfContainer["volume_mount"].([]interface{}) {
+		tfMountMap := tfMount.(map[string]interface{})
+		container.VolumeMounts = append(container.VolumeMounts, kubernetesKeyValue{
+			Key:    tfMountMap["volume_name"].(string),
+			Value:  tfMountMap["mount_path"].(string),
+			Option: tfMountMap["sub_path"].(string),
+		})
+	}
+
+	if tfResources := getSingleBlock(tfContainer["resources"]); tfResources != nil {
+		container.Resources.Requests.CPU = tfResources["cpu_request"].(string)
+		container.Resources.Requests.Memory = tfResources["memory_request"].(string)
+		container.Resources.Limits.CPU = tfResources["cpu_limit"].(string)
+		container.Resources.Limits.Memory = tfResources["memory_limit"].(string)
+	}
+
+	return container
+}
+
+func buildKubernetesProbe(tfProbe interface{}) *kubernetesProbe {
+	tfProbeMap := getSingleBlock(tfProbe)
+	if tfProbeMap == nil {
+		return nil
+	}
+
+	probe := &kubernetesProbe{
+		Type:                tfProbeMap["type"].(string),
+		InitialDelaySeconds: formatOptionalInt(tfProbeMap["initial_delay_seconds"].(int)),
+		PeriodSeconds:       formatOptionalInt(tfProbeMap["period_seconds"].(int)),
+		TimeoutSeconds:      formatOptionalInt(tfProbeMap["timeout_seconds"].(int)),
+		SuccessThreshold:    formatOptionalInt(tfProbeMap["success_threshold"].(int)),
+		FailureThreshold:    formatOptionalInt(tfProbeMap["failure_threshold"].(int)),
+		Exec: kubernetesProbeExec{
+			Command: []string{},
+		},
+		HTTPGet: kubernetesProbeHTTPGet{
+			HTTPHeaders: []kubernetesKeyValue{},
+		},
+	}
+
+	switch probe.Type {
+	case "Command":
+		if command := getSliceFromTerraformTypeList(tfProbeMap["command"]); command != nil {
+			probe.Exec.Command = command
+		}
+	case "HttpGet":
+		probe.HTTPGet.Host = tfProbeMap["host"].(string)
+		probe.HTTPGet.Path = tfProbeMap["path"].(string)
+		probe.HTTPGet.Port = tfProbeMap["port"].(string)
+		probe.HTTPGet.Scheme = tfProbeMap["scheme"].(string)
+	case "TcpSocket":
+		probe.TCPSocket.Host = tfProbeMap["host"].(string)
+		probe.TCPSocket.Port = tfProbeMap["port"].(string)
+	}
+
+	return probe
+}
+
+// getSingleBlock returns the only element of a block with MaxItems set to 1, or nil if it isn't configured
+func getSingleBlock(tfBlock interface{}) map[string]interface{} {
+	if tfBlock == nil {
+		return nil
+	}
+
+	tfBlockList := tfBlock.([]interface{})
+	if len(tfBlockList) == 0 || tfBlockList[0] == nil {
+		return nil
+	}
+
+	return tfBlockList[0].(map[string]interface{})
+}
+
+func buildStringMap(tfMap interface{}) map[string]string {
+	result := map[string]string{}
+
+	if tfMap != nil {
+		for key, value := range tfMap.(map[string]interface{}) {
+			result[key] = value.(string)
+		}
+	}
+
+	return result
+}
+
+// buildKubernetesKeyValues orders the entries by key, so the serialised property doesn't change between applies
+func buildKubernetesKeyValues(tfMap interface{}) []kubernetesKeyValue {
+	values := buildStringMap(tfMap)
+
+	keyValues := []kubernetesKeyValue{}
+	for _, key := range getSortedKeys(values) {
+		keyValues = append(keyValues, kubernetesKeyValue{Key: key, Value: values[key]})
+	}
+
+	return keyValues
+}
+
+func marshalKubernetesProperty(value interface{}) string {
+	j, _ := json.Marshal(value)
+	return string(j)
+}
+
+// formatOptionalInt formats an optional number, leaving it empty when it isn't set
+func formatOptionalInt(value int) string {
+	if value == 0 {
+		return ""
+	}
+	return strconv.Itoa(value)
+}
@@ -0,0 +1,138 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployDeployKubernetesContainersAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeployKubernetesContainersAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeployKubernetesContainersAction(),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeployKubernetesContainersAction() string {
+	return testAccBuildTestAction(`
+		deploy_kubernetes_containers_action {
+			name = "Deploy Containers"
+			run_on_server = true
+
+			deployment_name = "web"
+			namespace = "apps"
+			replicas = 2
+			deployment_strategy = "Recreate"
+
+			container {
+				name = "nginx"
+				package_id = "nginx"
+				feed_id = "feeds-builtin"
+
+				port {
+					name = "http"
+					container_port = 80
+				}
+
+				env {
+					name = "MODE"
+					value = "production"
+				}
+
+				readiness_probe {
+					type = "HttpGet"
+					path = "/healthz"
+					port = "http"
+				}
+			}
+
+			service {
+				name = "web"
+
+				port {
+					name = "http"
+					port = 80
+					target_port = "http"
+				}
+			}
+
+			ingress {
+				name = "web"
+				annotations = {
+					"nginx.ingress.kubernetes.io/rewrite-target" = "/"
+					"kubernetes.io/ingress.class" = "nginx"
+				}
+
+				rule {
+					host = "web.example.com"
+
+					path {
+						path = "/"
+						service_port = "http"
+					}
+				}
+			}
+
+			config_map {
+				name = "web-config"
+				values = {
+					key = "value"
+				}
+			}
+		}
+	`)
+}
+
+func testAccCheckDeployKubernetesContainersAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.KubernetesDeployContainers" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Octopus.Action.KubernetesContainers.DeploymentName"] != "web" {
+			return fmt.Errorf("DeploymentName is incorrect: %s", action.Properties["Octopus.Action.KubernetesContainers.DeploymentName"])
+		}
+
+		if action.Properties["Octopus.Action.KubernetesContainers.Replicas"] != "2" {
+			return fmt.Errorf("Replicas is incorrect: %s", action.Properties["Octopus.Action.KubernetesContainers.Replicas"])
+		}
+
+		if len(action.Packages) != 1 || action.Packages[0].Name != "nginx" {
+			return fmt.Errorf("Container package is incorrect: %v", action.Packages)
+		}
+
+		if action.Properties["Octopus.Action.KubernetesContainers.ServicePorts"] != `[{"name":"http","port":"80","targetPort":"http","nodePort":"","protocol":"TCP"}]` {
+			return fmt.Errorf("ServicePorts is incorrect: %s", action.Properties["Octopus.Action.KubernetesContainers.ServicePorts"])
+		}
+
+		if action.Properties["Octopus.Action.KubernetesContainers.IngressAnnotations"] != `[{"key":"kubernetes.io/ingress.class","value":"nginx"},{"key":"nginx.ingress.kubernetes.io/rewrite-target","value":"/"}]` {
+			return fmt.Errorf("IngressAnnotations is incorrect: %s", action.Properties["Octopus.Action.KubernetesContainers.IngressAnnotations"])
+		}
+
+		if action.Properties["Octopus.Action.KubernetesContainers.ConfigMapValues"] != `{"key":"value"}` {
+			return fmt.Errorf("ConfigMapValues is incorrect: %s", action.Properties["Octopus.Action.KubernetesContainers.ConfigMapValues"])
+		}
+
+		return nil
+	}
+}
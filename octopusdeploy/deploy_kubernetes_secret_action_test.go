@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -48,7 +47,7 @@ func testAccDeployKuberentesSecretAction() string {
 
 func testAccCheckDeployKuberentesSecretAction() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 
 		process, err := getDeploymentProcess(s, client)
 		if err != nil {
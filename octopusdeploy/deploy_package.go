@@ -8,14 +8,15 @@ import (
 func getDeployPackageAction() *schema.Schema {
 	actionSchema, element := getCommonDeploymentActionSchema()
 	addPrimaryPackageSchema(element, true)
-	//addCustomInstallationDirectoryFeature(element)
-	//addIisWebSiteAndApplicationPoolFeature(element)
+	addCustomInstallationDirectoryFeature(element)
+	addIisWebSiteAndApplicationPoolFeature(element)
+	addNginxFeature(element)
 	addWindowsServiceFeature(element)
-	//addCustomDeploymentScriptsFeature(element)
-	//addJsonConfigurationVariablesFeature(element)
-	//addConfigurationVariablesFeature(element)
-	//addConfigurationTransformsFeature(element)
-	//addSubstituteVariablesInFilesFeature(element)
+	addCustomDeploymentScriptsFeature(element)
+	addStructuredConfigurationVariablesFeature(element)
+	addConfigurationVariablesFeature(element)
+	addConfigurationTransformsFeature(element)
+	addSubstituteVariablesInFilesFeature(element)
 	//addIis6HomeDirectoryFeature(element)
 	//addRedGateDatabaseDeploymentFeature(element)
 	return actionSchema
@@ -25,5 +26,6 @@ func buildDeployPackageActionResource(tfAction map[string]interface{}) octopusde
 	action := buildDeploymentActionResource(tfAction)
 	action.ActionType = "Octopus.TentaclePackage"
 	addWindowsServiceFeatureToActionResource(tfAction, action)
+	addPackageFeaturesToActionResource(tfAction, action)
 	return action
 }
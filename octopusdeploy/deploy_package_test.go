@@ -0,0 +1,213 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployDeployPackageActionFeatures(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeployPackageActionFeatures(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeployPackageActionFeatures(),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeployPackageActionFeatures() string {
+	return testAccBuildTestAction(`
+		deploy_package_action {
+			name = "Test"
+
+			primary_package {
+				package_id = "MyPackage"
+			}
+
+			custom_installation_directory {
+				directory = "C:\\Apps\\MyPackage"
+				purge_before_deployment = true
+				exclude_from_purge = ["logs\\**"]
+			}
+
+			structured_configuration_variables {
+				target_files = ["appsettings.json", "config.yaml"]
+			}
+
+			substitute_in_files {
+				target_files = ["index.html"]
+			}
+
+			configuration_transforms {
+				additional_transforms = "Web.Local.config => Web.config"
+			}
+
+			configuration_variables {}
+
+			custom_scripts {
+				pre_deploy {
+					body = "Stop-Service MyService"
+				}
+
+				post_deploy {
+					syntax = "Bash"
+					body = "echo done"
+				}
+			}
+		}
+	`)
+}
+
+func testAccCheckDeployPackageActionFeatures() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		expectedFeatures := "Octopus.Features.CustomDirectory,Octopus.Features.JsonConfigurationVariables,Octopus.Features.SubstituteInFiles,Octopus.Features.ConfigurationTransforms,Octopus.Features.ConfigurationVariables,Octopus.Features.CustomScripts"
+		if action.Properties["Octopus.Action.EnabledFeatures"] != expectedFeatures {
+			return fmt.Errorf("EnabledFeatures is incorrect: %s", action.Properties["Octopus.Action.EnabledFeatures"])
+		}
+
+		if action.Properties["Octopus.Action.Package.CustomInstallationDirectory"] != "C:\\Apps\\MyPackage" {
+			return fmt.Errorf("CustomInstallationDirectory is incorrect: %s", action.Properties["Octopus.Action.Package.CustomInstallationDirectory"])
+		}
+
+		if action.Properties["Octopus.Action.Package.JsonConfigurationVariablesTargets"] != "appsettings.json\nconfig.yaml" {
+			return fmt.Errorf("JsonConfigurationVariablesTargets is incorrect: %s", action.Properties["Octopus.Action.Package.JsonConfigurationVariablesTargets"])
+		}
+
+		if action.Properties["Octopus.Action.SubstituteInFiles.TargetFiles"] != "index.html" {
+			return fmt.Errorf("TargetFiles is incorrect: %s", action.Properties["Octopus.Action.SubstituteInFiles.TargetFiles"])
+		}
+
+		if action.Properties["Octopus.Action.Package.AdditionalXmlConfigurationTransforms"] != "Web.Local.config => Web.config" {
+			return fmt.Errorf("AdditionalXmlConfigurationTransforms is incorrect: %s", action.Properties["Octopus.Action.Package.AdditionalXmlConfigurationTransforms"])
+		}
+
+		if action.Properties["Octopus.Action.CustomScripts.PreDeploy.ps1"] != "Stop-Service MyService" {
+			return fmt.Errorf("PreDeploy script is incorrect: %s", action.Properties["Octopus.Action.CustomScripts.PreDeploy.ps1"])
+		}
+
+		if action.Properties["Octopus.Action.CustomScripts.PostDeploy.sh"] != "echo done" {
+			return fmt.Errorf("PostDeploy script is incorrect: %s", action.Properties["Octopus.Action.CustomScripts.PostDeploy.sh"])
+		}
+
+		return nil
+	}
+}
+
+func TestAccOctopusDeployDeployPackageActionWebServerFeatures(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeployPackageActionWebServerFeatures(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeployPackageActionWebServerFeatures(),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeployPackageActionWebServerFeatures() string {
+	return testAccBuildTestAction(`
+		deploy_package_action {
+			name = "Test"
+
+			primary_package {
+				package_id = "MyPackage"
+			}
+
+			iis_web_site {
+				website_name = "MyWebSite"
+				application_pool_name = "MyAppPool"
+
+				binding {
+					protocol = "https"
+					port = "443"
+					certificate_variable = "MyCertificate"
+				}
+			}
+
+			nginx {
+				host_name = "example.com"
+
+				binding {
+					port = "8080"
+				}
+
+				location {
+					path = "/"
+					directives = {
+						root = "/var/www/mypackage"
+					}
+				}
+			}
+		}
+	`)
+}
+
+func testAccCheckDeployPackageActionWebServerFeatures() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		expectedFeatures := "Octopus.Features.IISWebSite,Octopus.Features.Nginx"
+		if action.Properties["Octopus.Action.EnabledFeatures"] != expectedFeatures {
+			return fmt.Errorf("EnabledFeatures is incorrect: %s", action.Properties["Octopus.Action.EnabledFeatures"])
+		}
+
+		if action.Properties["Octopus.Action.IISWebSite.WebSiteName"] != "MyWebSite" {
+			return fmt.Errorf("WebSiteName is incorrect: %s", action.Properties["Octopus.Action.IISWebSite.WebSiteName"])
+		}
+
+		if action.Properties["Octopus.Action.IISWebSite.ApplicationPoolName"] != "MyAppPool" {
+			return fmt.Errorf("ApplicationPoolName is incorrect: %s", action.Properties["Octopus.Action.IISWebSite.ApplicationPoolName"])
+		}
+
+		expectedIisBindings := `[{"protocol":"https","ipAddress":"*","port":"443","host":null,"thumbprint":null,"certificateVariable":"MyCertificate","requireSni":false,"enabled":true}]`
+		if action.Properties["Octopus.Action.IISWebSite.Bindings"] != expectedIisBindings {
+			return fmt.Errorf("IIS Bindings are incorrect: %s", action.Properties["Octopus.Action.IISWebSite.Bindings"])
+		}
+
+		if action.Properties["Octopus.Action.Nginx.Server.HostName"] != "example.com" {
+			return fmt.Errorf("Nginx HostName is incorrect: %s", action.Properties["Octopus.Action.Nginx.Server.HostName"])
+		}
+
+		expectedNginxBindings := `[{"protocol":"http","ipAddress":"*","port":"8080","certificateVariable":"","enabled":true}]`
+		if action.Properties["Octopus.Action.Nginx.Server.Bindings"] != expectedNginxBindings {
+			return fmt.Errorf("Nginx Bindings are incorrect: %s", action.Properties["Octopus.Action.Nginx.Server.Bindings"])
+		}
+
+		expectedNginxLocations := `[{"path":"/","directives":"{\"root\":\"/var/www/mypackage\"}","headers":"{}","reverseProxy":"False","reverseProxyUrl":""}]`
+		if action.Properties["Octopus.Action.Nginx.Server.Locations"] != expectedNginxLocations {
+			return fmt.Errorf("Nginx Locations are incorrect: %s", action.Properties["Octopus.Action.Nginx.Server.Locations"])
+		}
+
+		return nil
+	}
+}
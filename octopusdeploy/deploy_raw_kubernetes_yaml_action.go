@@ -0,0 +1,91 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func getDeployRawKubernetesYamlActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addExecutionLocationSchema(element)
+	addWorkerPoolSchema(element)
+	addPrimaryPackageSchema(element, false)
+	addSubstituteVariablesInFilesFeature(element)
+
+	element.Schema["yaml"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The Kubernetes resources to deploy, as inline YAML",
+		Optional:    true,
+	}
+
+	element.Schema["file_names"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The YAML files in the primary package to deploy. Extended wildcard syntax is supported.",
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	element.Schema["namespace"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The namespace to deploy to. Defaults to the namespace of the target",
+		Optional:    true,
+	}
+
+	element.Schema["wait_for_deployment"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Wait for the resources to be ready before continuing",
+		Optional:    true,
+		Default:     false,
+	}
+
+	return actionSchema
+}
+
+// validateRawKubernetesYamlSource checks that the YAML comes either inline or from files in the primary package
+func validateRawKubernetesYamlSource(tfAction map[string]interface{}) error {
+	name := tfAction["name"].(string)
+	hasYaml := tfAction["yaml"].(string) != ""
+	hasFiles := len(tfAction["file_names"].([]interface{})) > 0
+
+	if hasYaml == hasFiles {
+		return fmt.Errorf("action %s: exactly one of yaml and file_names must be set", name)
+	}
+
+	if hasFiles && tfAction["primary_package"].(*schema.Set).Len() == 0 {
+		return fmt.Errorf("action %s: primary_package must be set when file_names is set", name)
+	}
+
+	return nil
+}
+
+func buildDeployRawKubernetesYamlActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.KubernetesDeployRawYaml"
+
+	resource.Properties["Octopus.Action.KubernetesContainers.Namespace"] = tfAction["namespace"].(string)
+
+	if yaml := tfAction["yaml"].(string); yaml != "" {
+		resource.Properties["Octopus.Action.Script.ScriptSource"] = scriptSourceInline
+		resource.Properties["Octopus.Action.KubernetesContainers.CustomResourceYaml"] = yaml
+	} else {
+		resource.Properties["Octopus.Action.Script.ScriptSource"] = scriptSourcePackage
+		resource.Properties["Octopus.Action.KubernetesContainers.CustomResourceYamlFileName"] = strings.Join(getSliceFromTerraformTypeList(tfAction["file_names"]), "\n")
+	}
+
+	if tfAction["wait_for_deployment"].(bool) {
+		resource.Properties["Octopus.Action.KubernetesContainers.DeploymentWait"] = "Wait"
+	} else {
+		resource.Properties["Octopus.Action.KubernetesContainers.DeploymentWait"] = "NoWait"
+	}
+
+	addPackageFeaturesToActionResource(tfAction, resource)
+
+	return resource
+}
@@ -0,0 +1,76 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployDeployRawKubernetesYamlAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeployRawKubernetesYamlAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeployRawKubernetesYamlAction(),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeployRawKubernetesYamlAction() string {
+	return testAccBuildTestAction(`
+		deploy_raw_kubernetes_yaml_action {
+			name = "Deploy YAML"
+			run_on_server = true
+
+			primary_package {
+				package_id = "MyManifests"
+			}
+
+			file_names = ["deployment.yaml", "service.yaml"]
+			namespace = "apps"
+
+			substitute_in_files {
+				target_files = ["*.yaml"]
+			}
+		}
+	`)
+}
+
+func testAccCheckDeployRawKubernetesYamlAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.KubernetesDeployRawYaml" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Octopus.Action.Script.ScriptSource"] != "Package" {
+			return fmt.Errorf("ScriptSource is incorrect: %s", action.Properties["Octopus.Action.Script.ScriptSource"])
+		}
+
+		if action.Properties["Octopus.Action.KubernetesContainers.CustomResourceYamlFileName"] != "deployment.yaml\nservice.yaml" {
+			return fmt.Errorf("CustomResourceYamlFileName is incorrect: %s", action.Properties["Octopus.Action.KubernetesContainers.CustomResourceYamlFileName"])
+		}
+
+		if action.Properties["Octopus.Action.SubstituteInFiles.TargetFiles"] != "*.yaml" {
+			return fmt.Errorf("TargetFiles is incorrect: %s", action.Properties["Octopus.Action.SubstituteInFiles.TargetFiles"])
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,53 @@
+package octopusdeploy
+
+import (
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func getDeployTomcatActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addPackagesSchema(element, true)
+	element.Schema["primary_package"].Description = "The WAR file to deploy"
+	addTomcatManagerSchema(element)
+	addStructuredConfigurationVariablesFeature(element)
+	addSubstituteVariablesInFilesFeature(element)
+
+	element.Schema["context_path"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The context path of the application. Defaults to the package ID",
+		Optional:    true,
+	}
+
+	element.Schema["deployment_version"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The version of the application, for parallel deployments",
+		Optional:    true,
+	}
+
+	element.Schema["enabled"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Start the application once it is deployed",
+		Optional:    true,
+		Default:     true,
+	}
+
+	return actionSchema
+}
+
+func buildDeployTomcatActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.TomcatDeploy"
+
+	resource.Properties = merge(resource.Properties, buildTomcatManagerProperties(tfAction))
+
+	resource.Properties["Tomcat.Deploy.Name"] = tfAction["context_path"].(string)
+	resource.Properties["Tomcat.Deploy.Version"] = tfAction["deployment_version"].(string)
+	resource.Properties["Tomcat.Deploy.Enabled"] = formatBool(tfAction["enabled"].(bool))
+
+	addPackageFeaturesToActionResource(tfAction, resource)
+
+	return resource
+}
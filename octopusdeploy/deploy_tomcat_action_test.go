@@ -0,0 +1,101 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployDeployTomcatAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeployTomcatAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeployTomcatAction(),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployDeployTomcatActionMissingPassword(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBuildTestAction(`
+					deploy_tomcat_action {
+						name = "Deploy WAR"
+						manager_url = "http://localhost:8080/manager"
+						username = "tomcat"
+
+						primary_package {
+							package_id = "MyApp"
+						}
+					}
+				`),
+				ExpectError: regexp.MustCompile("username and password must be set together"),
+			},
+		},
+	})
+}
+
+func testAccDeployTomcatAction() string {
+	return testAccBuildTestAction(`
+		deploy_tomcat_action {
+			name = "Deploy WAR"
+			manager_url = "http://localhost:8080/manager"
+			username = "tomcat"
+			password = "secret"
+			context_path = "/app"
+			enabled = false
+
+			primary_package {
+				package_id = "MyApp"
+			}
+		}
+	`)
+}
+
+func testAccCheckDeployTomcatAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.TomcatDeploy" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Tomcat.Deploy.Controller"] != "http://localhost:8080/manager" {
+			return fmt.Errorf("Controller is incorrect: %s", action.Properties["Tomcat.Deploy.Controller"])
+		}
+
+		if action.Properties["Tomcat.Deploy.User"] != "tomcat" {
+			return fmt.Errorf("User is incorrect: %s", action.Properties["Tomcat.Deploy.User"])
+		}
+
+		if action.Properties["Tomcat.Deploy.Name"] != "/app" {
+			return fmt.Errorf("Name is incorrect: %s", action.Properties["Tomcat.Deploy.Name"])
+		}
+
+		if action.Properties["Tomcat.Deploy.Enabled"] != "False" {
+			return fmt.Errorf("Enabled is incorrect: %s", action.Properties["Tomcat.Deploy.Enabled"])
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,51 @@
+package octopusdeploy
+
+import (
+	"strings"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func getDeployWildFlyActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addPackagesSchema(element, true)
+	element.Schema["primary_package"].Description = "The WAR or EAR file to deploy"
+	addWildFlyManagementSchema(element)
+	addWildFlyServerGroupsSchema(element)
+	addStructuredConfigurationVariablesFeature(element)
+	addSubstituteVariablesInFilesFeature(element)
+
+	element.Schema["deployment_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the deployment. Defaults to the package file name",
+		Optional:    true,
+	}
+
+	element.Schema["enabled"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Enable the deployment once it is deployed, for standalone servers",
+		Optional:    true,
+		Default:     true,
+	}
+
+	return actionSchema
+}
+
+func buildDeployWildFlyActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.WildFlyDeploy"
+
+	resource.Properties = merge(resource.Properties, buildWildFlyManagementProperties(tfAction))
+
+	resource.Properties["WildFly.Deploy.Name"] = tfAction["deployment_name"].(string)
+	resource.Properties["WildFly.Deploy.Enabled"] = formatBool(tfAction["enabled"].(bool))
+	resource.Properties["WildFly.Deploy.EnabledServerGroup"] = strings.Join(getSliceFromTerraformTypeList(tfAction["enabled_server_groups"]), ",")
+	resource.Properties["WildFly.Deploy.DisabledServerGroup"] = strings.Join(getSliceFromTerraformTypeList(tfAction["disabled_server_groups"]), ",")
+
+	addPackageFeaturesToActionResource(tfAction, resource)
+
+	return resource
+}
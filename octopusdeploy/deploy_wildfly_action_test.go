@@ -0,0 +1,77 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployDeployWildFlyAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeployWildFlyAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeployWildFlyAction(),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeployWildFlyAction() string {
+	return testAccBuildTestAction(`
+		deploy_wildfly_action {
+			name = "Deploy EAR"
+			host = "wildfly.example.com"
+			server_type = "Domain"
+			username = "admin"
+			password = "secret"
+			enabled_server_groups = ["main-server-group", "other-server-group"]
+
+			primary_package {
+				package_id = "MyApp"
+			}
+		}
+	`)
+}
+
+func testAccCheckDeployWildFlyAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.WildFlyDeploy" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["WildFly.Deploy.Controller"] != "wildfly.example.com" {
+			return fmt.Errorf("Controller is incorrect: %s", action.Properties["WildFly.Deploy.Controller"])
+		}
+
+		if action.Properties["WildFly.Deploy.Port"] != "9990" {
+			return fmt.Errorf("Port is incorrect: %s", action.Properties["WildFly.Deploy.Port"])
+		}
+
+		if action.Properties["WildFly.Deploy.ServerType"] != "Domain" {
+			return fmt.Errorf("ServerType is incorrect: %s", action.Properties["WildFly.Deploy.ServerType"])
+		}
+
+		if action.Properties["WildFly.Deploy.EnabledServerGroup"] != "main-server-group,other-server-group" {
+			return fmt.Errorf("EnabledServerGroup is incorrect: %s", action.Properties["WildFly.Deploy.EnabledServerGroup"])
+		}
+
+		return nil
+	}
+}
@@ -1,7 +1,11 @@
 package octopusdeploy
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/config/hcl2shim"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -9,12 +13,12 @@ func getDeployWindowsServiceActionSchema() *schema.Schema {
 	actionSchema, element := getCommonDeploymentActionSchema()
 	addPrimaryPackageSchema(element, true)
 	addDeployWindowsServiceSchema(element)
-	//addCustomInstallationDirectoryFeature(element)
-	//addCustomDeploymentScriptsFeature(element)
-	//addJsonConfigurationVariablesFeature(element)
-	//addConfigurationVariablesFeature(element)
-	//addConfigurationTransformsFeature(element)
-	//addSubstituteVariablesInFilesFeature(element)
+	addCustomInstallationDirectoryFeature(element)
+	addCustomDeploymentScriptsFeature(element)
+	addStructuredConfigurationVariablesFeature(element)
+	addConfigurationVariablesFeature(element)
+	addConfigurationTransformsFeature(element)
+	addSubstituteVariablesInFilesFeature(element)
 	return actionSchema
 }
 
@@ -73,6 +77,7 @@ func addDeployWindowsServiceSchema(element *schema.Resource) {
 		Type:        schema.TypeString,
 		Description: "The password for the custom account",
 		Optional:    true,
+		Sensitive:   true,
 	}
 	element.Schema["start_mode"] = &schema.Schema{
 		Type:        schema.TypeString,
@@ -85,12 +90,108 @@ func addDeployWindowsServiceSchema(element *schema.Resource) {
 		Description: "Any dependencies that the service has. Separate the names using forward slashes (/).",
 		Optional:    true,
 	}
+	element.Schema["desired_status"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The status of the service after the deployment. Default starts the service when start_mode is auto or delayed-auto",
+		Optional:    true,
+		Default:     "Default",
+		ValidateFunc: validateValueFunc([]string{
+			"Default",
+			"Started",
+			"Stopped",
+			"Unchanged",
+		}),
+	}
+}
+
+// validateWindowsService checks the custom account settings are only used with the _CUSTOM service account.
+// Accounts bound to a variable expression are checked by Octopus at deployment, and accounts not known until
+// apply are not checked.
+func validateWindowsService(tfService map[string]interface{}) error {
+	serviceAccount := tfService["service_account"].(string)
+	accountName, _ := tfService["custom_account_name"].(string)
+	accountPassword, _ := tfService["custom_account_password"].(string)
+
+	if serviceAccount == "_CUSTOM" {
+		if accountName == "" {
+			return fmt.Errorf("windows service %s: custom_account_name is required when service_account is _CUSTOM", tfService["service_name"])
+		}
+	} else if !strings.Contains(serviceAccount, "#{") && serviceAccount != hcl2shim.UnknownVariableValue && (accountName != "" || accountPassword != "") {
+		return fmt.Errorf("windows service %s: custom_account_name and custom_account_password require service_account to be _CUSTOM", tfService["service_name"])
+	}
+
+	return nil
+}
+
+func validateDeployPackageAction(tfAction map[string]interface{}) error {
+	if windowsServiceList, ok := tfAction["windows_service"]; ok {
+		for _, tfWindowsService := range windowsServiceList.(*schema.Set).List() {
+			if err := validateWindowsService(tfWindowsService.(map[string]interface{})); err != nil {
+				return err
+			}
+		}
+	}
+
+	if tfFeature := getFeature(tfAction, "iis_web_site"); tfFeature != nil {
+		if err := validateIisBindings(tfFeature["binding"].([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if tfFeature := getFeature(tfAction, "nginx"); tfFeature != nil {
+		if err := validateNginxBindings(tfFeature["binding"].([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flattenWindowsServiceProperties sets the windows service attributes from the action properties. The
+// custom account password is left as configured, as it is write only.
+func flattenWindowsServiceProperties(tfService map[string]interface{}, properties map[string]string) {
+	tfService["service_name"] = properties["Octopus.Action.WindowsService.ServiceName"]
+	tfService["display_name"] = properties["Octopus.Action.WindowsService.DisplayName"]
+	tfService["description"] = properties["Octopus.Action.WindowsService.Description"]
+	tfService["executable_path"] = properties["Octopus.Action.WindowsService.ExecutablePath"]
+	tfService["arguments"] = properties["Octopus.Action.WindowsService.Arguments"]
+	tfService["service_account"] = properties["Octopus.Action.WindowsService.ServiceAccount"]
+	tfService["custom_account_name"] = properties["Octopus.Action.WindowsService.CustomAccountName"]
+	tfService["start_mode"] = properties["Octopus.Action.WindowsService.StartMode"]
+	tfService["dependencies"] = properties["Octopus.Action.WindowsService.Dependencies"]
+
+	if desiredStatus, ok := properties["Octopus.Action.WindowsService.DesiredStatus"]; ok {
+		tfService["desired_status"] = desiredStatus
+	}
+}
+
+func flattenDeployWindowsServiceAction(tfAction map[string]interface{}, properties map[string]string) {
+	flattenPackageFeatures(tfAction, properties)
+	flattenWindowsServiceProperties(tfAction, properties)
+}
+
+func flattenDeployPackageAction(tfAction map[string]interface{}, properties map[string]string) {
+	flattenPackageFeatures(tfAction, properties)
+
+	if !isFeatureEnabled(properties, featureWindowsService) {
+		tfAction["windows_service"] = []interface{}{}
+		return
+	}
+
+	tfService := map[string]interface{}{}
+	if windowsServiceList, ok := tfAction["windows_service"].(*schema.Set); ok && windowsServiceList.Len() > 0 {
+		tfService["custom_account_password"] = windowsServiceList.List()[0].(map[string]interface{})["custom_account_password"]
+	}
+
+	flattenWindowsServiceProperties(tfService, properties)
+	tfAction["windows_service"] = []interface{}{tfService}
 }
 
 func buildDeployWindowsServiceActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
 	resource := buildDeploymentActionResource(tfAction)
 	resource.ActionType = "Octopus.WindowsService"
 	addWindowsServiceToActionResource(tfAction, resource)
+	addPackageFeaturesToActionResource(tfAction, resource)
 	return resource
 }
 
@@ -104,6 +205,7 @@ func addWindowsServiceFeatureToActionResource(tfAction map[string]interface{}, a
 }
 
 func addWindowsServiceToActionResource(tfAction map[string]interface{}, action octopusdeploy.DeploymentAction) {
+	enableFeatures(action.Properties, featureWindowsService)
 	action.Properties["Octopus.Action.WindowsService.CreateOrUpdateService"] = "True"
 	action.Properties["Octopus.Action.WindowsService.ServiceName"] = tfAction["service_name"].(string)
 
@@ -142,4 +244,6 @@ func addWindowsServiceToActionResource(tfAction map[string]interface{}, action o
 	if dependencies != nil {
 		action.Properties["Octopus.Action.WindowsService.Dependencies"] = dependencies.(string)
 	}
+
+	action.Properties["Octopus.Action.WindowsService.DesiredStatus"] = tfAction["desired_status"].(string)
 }
@@ -2,9 +2,9 @@ package octopusdeploy
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
-	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -41,6 +41,82 @@ func TestAccOctopusDeployWindowsServiceFeature(t *testing.T) {
 	})
 }
 
+func TestAccOctopusDeployDeployWindowsServiceActionInvalidAccount(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeployWindowsServiceActionWithAccount(`service_account = "_CUSTOM"`),
+				ExpectError: regexp.MustCompile("custom_account_name is required when service_account is _CUSTOM"),
+			},
+			{
+				Config:      testAccDeployWindowsServiceActionWithAccount(`custom_account_name = "User"`),
+				ExpectError: regexp.MustCompile("require service_account to be _CUSTOM"),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployDeployWindowsServiceActionInterpolatedAccount(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The service account isn't known until the project is created
+				Config: testAccDeployWindowsServiceActionWithAccount(`
+					service_account = replace(octopusdeploy_project.test.id, "/.*/", "_CUSTOM")
+					custom_account_name = "User"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeployWindowsServiceActionAccount("_CUSTOM", "User"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeployWindowsServiceActionAccount(expectedAccount string, expectedAccountName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.Properties["Octopus.Action.WindowsService.ServiceAccount"] != expectedAccount {
+			return fmt.Errorf("ServiceAccount is incorrect: %s", action.Properties["Octopus.Action.WindowsService.ServiceAccount"])
+		}
+
+		if action.Properties["Octopus.Action.WindowsService.CustomAccountName"] != expectedAccountName {
+			return fmt.Errorf("CustomAccountName is incorrect: %s", action.Properties["Octopus.Action.WindowsService.CustomAccountName"])
+		}
+
+		return nil
+	}
+}
+
+func testAccDeployWindowsServiceActionWithAccount(account string) string {
+	return testAccBuildTestAction(fmt.Sprintf(`
+		deploy_windows_service_action {
+			name = "Test"
+
+			primary_package {
+				package_id = "MyPackage"
+			}
+
+			service_name = "MyService"
+			executable_path = "MyService.exe"
+			%s
+		}
+	`, account))
+}
+
 func testAccDeployWindowsServiceAction() string {
 	return testAccBuildTestAction(`
 		deploy_windows_service_action {
@@ -60,6 +136,7 @@ func testAccDeployWindowsServiceAction() string {
 			custom_account_password = "Password"
 			start_mode = "manual"
 			dependencies = "OtherService"
+			desired_status = "Stopped"
 		}
 	`)
 }
@@ -84,6 +161,7 @@ func testAccWindowsServiceFeature() string {
 				custom_account_password = "Password"
 				start_mode = "manual"
 				dependencies = "OtherService"
+				desired_status = "Stopped"
 			}
 		}
 	`)
@@ -91,7 +169,7 @@ func testAccWindowsServiceFeature() string {
 
 func testAccCheckDeployWindowsServiceActionOrFeature(expectedActionType string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 
 		process, err := getDeploymentProcess(s, client)
 		if err != nil {
@@ -152,6 +230,10 @@ func testAccCheckDeployWindowsServiceActionOrFeature(expectedActionType string)
 			return fmt.Errorf("Dependencies is incorrect: %s", action.Properties["Octopus.Action.WindowsService.Dependencies"])
 		}
 
+		if action.Properties["Octopus.Action.WindowsService.DesiredStatus"] != "Stopped" {
+			return fmt.Errorf("Desired Status is incorrect: %s", action.Properties["Octopus.Action.WindowsService.DesiredStatus"])
+		}
+
 		return nil
 	}
 }
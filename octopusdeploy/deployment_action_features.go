@@ -0,0 +1,715 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/config/hcl2shim"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const enabledFeaturesProperty = "Octopus.Action.EnabledFeatures"
+
+const (
+	featureCustomDirectory            = "Octopus.Features.CustomDirectory"
+	featureCustomScripts              = "Octopus.Features.CustomScripts"
+	featureIISWebSite                 = "Octopus.Features.IISWebSite"
+	featureWindowsService             = "Octopus.Features.WindowsService"
+	featureJsonConfigurationVariables = "Octopus.Features.JsonConfigurationVariables"
+	featureConfigurationTransforms    = "Octopus.Features.ConfigurationTransforms"
+	featureConfigurationVariables     = "Octopus.Features.ConfigurationVariables"
+	featureSubstituteInFiles          = "Octopus.Features.SubstituteInFiles"
+	featureNginx                      = "Octopus.Features.Nginx"
+)
+
+// parseEnabledFeatures splits the EnabledFeatures property, dropping empty entries and duplicates
+func parseEnabledFeatures(value string) []string {
+	var features []string
+
+	for _, feature := range strings.Split(value, ",") {
+		feature = strings.TrimSpace(feature)
+		if feature != "" && !validateStringInSlice(feature, features) {
+			features = append(features, feature)
+		}
+	}
+
+	return features
+}
+
+// enableFeatures adds the features to the EnabledFeatures property of an action, keeping any already enabled
+func enableFeatures(properties map[string]string, features ...string) {
+	enabled := parseEnabledFeatures(properties[enabledFeaturesProperty])
+
+	for _, feature := range features {
+		if !validateStringInSlice(feature, enabled) {
+			enabled = append(enabled, feature)
+		}
+	}
+
+	properties[enabledFeaturesProperty] = strings.Join(enabled, ",")
+}
+
+func isFeatureEnabled(properties map[string]string, feature string) bool {
+	return validateStringInSlice(feature, parseEnabledFeatures(properties[enabledFeaturesProperty]))
+}
+
+func addFeatureSchema(parent *schema.Resource, name string, description string, element *schema.Resource) {
+	parent.Schema[name] = &schema.Schema{
+		Description: description,
+		Type:        schema.TypeSet,
+		Optional:    true,
+		MaxItems:    1,
+		Elem:        element,
+	}
+}
+
+// getFeature returns the single block of a feature, or nil if the feature isn't configured
+func getFeature(tfAction map[string]interface{}, name string) map[string]interface{} {
+	if tfFeature, ok := tfAction[name]; ok && tfFeature != nil {
+		tfFeatureList := tfFeature.(*schema.Set).List()
+		if len(tfFeatureList) > 0 {
+			return tfFeatureList[0].(map[string]interface{})
+		}
+	}
+
+	return nil
+}
+
+func getFeatureTargetFilesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Required:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func splitFeatureTargetFiles(value string) []string {
+	var targetFiles []string
+
+	for _, targetFile := range strings.Split(value, "\n") {
+		if targetFile = strings.TrimSpace(targetFile); targetFile != "" {
+			targetFiles = append(targetFiles, targetFile)
+		}
+	}
+
+	return targetFiles
+}
+
+func addCustomInstallationDirectoryFeature(parent *schema.Resource) {
+	addFeatureSchema(parent, "custom_installation_directory", "Install the package to a specific directory", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"directory": {
+				Type:        schema.TypeString,
+				Description: "The directory the package will be installed to",
+				Required:    true,
+			},
+			"purge_before_deployment": {
+				Type:        schema.TypeBool,
+				Description: "Delete all files in the directory before installing the package",
+				Optional:    true,
+				Default:     false,
+			},
+			"exclude_from_purge": {
+				Type:        schema.TypeList,
+				Description: "Files and directories to keep when purging. Extended wildcard syntax is supported.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	})
+}
+
+func addStructuredConfigurationVariablesFeature(parent *schema.Resource) {
+	addFeatureSchema(parent, "structured_configuration_variables", "Replace values in JSON, XML and YAML files with matching variables", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"target_files": getFeatureTargetFilesSchema("The files to update, relative to the package contents. Extended wildcard syntax is supported."),
+		},
+	})
+}
+
+func addSubstituteVariablesInFilesFeature(parent *schema.Resource) {
+	addFeatureSchema(parent, "substitute_in_files", "Replace variable expressions in files with their values", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"target_files": getFeatureTargetFilesSchema("The files to transform, relative to the package contents. Extended wildcard syntax is supported."),
+		},
+	})
+}
+
+func addConfigurationTransformsFeature(parent *schema.Resource) {
+	addFeatureSchema(parent, "configuration_transforms", "Run XML configuration transforms", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"automatically_run": {
+				Type:        schema.TypeBool,
+				Description: "Run Web.Release.config and Web.<Environment>.config style transforms automatically",
+				Optional:    true,
+				Default:     true,
+			},
+			"additional_transforms": {
+				Type:        schema.TypeString,
+				Description: "A newline-separated list of additional transforms, e.g. Web.Local.config => Web.config",
+				Optional:    true,
+			},
+		},
+	})
+}
+
+func addConfigurationVariablesFeature(parent *schema.Resource) {
+	addFeatureSchema(parent, "configuration_variables", "Replace appSettings and connectionStrings in .config files with matching variables", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"replace_app_settings_and_connection_strings": {
+				Type:        schema.TypeBool,
+				Description: "Replace entries in the appSettings and connectionStrings sections",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	})
+}
+
+func addIisWebSiteAndApplicationPoolFeature(parent *schema.Resource) {
+	addFeatureSchema(parent, "iis_web_site", "Create or update an IIS web site and application pool pointing at the package", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"website_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the web site in IIS to create or reconfigure",
+				Required:    true,
+			},
+			"application_pool_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the application pool in IIS to create or reconfigure",
+				Required:    true,
+			},
+			"application_pool_framework": {
+				Type:         schema.TypeString,
+				Description:  "The version of the .NET common language runtime the application pool will use",
+				Optional:     true,
+				Default:      "v4.0",
+				ValidateFunc: validateValueFunc([]string{"v2.0", "v4.0"}),
+			},
+			"application_pool_identity": {
+				Type:        schema.TypeString,
+				Description: "The account the application pool will run under",
+				Optional:    true,
+				Default:     "ApplicationPoolIdentity",
+			},
+			"start_web_site": {
+				Type:        schema.TypeBool,
+				Description: "Start the web site after deployment",
+				Optional:    true,
+				Default:     true,
+			},
+			"start_application_pool": {
+				Type:        schema.TypeBool,
+				Description: "Start the application pool after deployment",
+				Optional:    true,
+				Default:     true,
+			},
+			"anonymous_authentication": {
+				Type:        schema.TypeBool,
+				Description: "Whether IIS should allow anonymous authentication",
+				Optional:    true,
+				Default:     false,
+			},
+			"basic_authentication": {
+				Type:        schema.TypeBool,
+				Description: "Whether IIS should allow basic authentication with a 401 challenge",
+				Optional:    true,
+				Default:     false,
+			},
+			"windows_authentication": {
+				Type:        schema.TypeBool,
+				Description: "Whether IIS should allow integrated Windows authentication with a 401 challenge",
+				Optional:    true,
+				Default:     true,
+			},
+			"binding": getIisBindingSchema(),
+		},
+	})
+}
+
+func addNginxFeature(parent *schema.Resource) {
+	addFeatureSchema(parent, "nginx", "Configure an Nginx server to host the package", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"host_name": {
+				Type:        schema.TypeString,
+				Description: "The host name the server responds to. Leave empty to respond to any host.",
+				Optional:    true,
+			},
+			"binding": {
+				Type:        schema.TypeList,
+				Description: "The addresses and ports the server listens on",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:         schema.TypeString,
+							Description:  "Protocol to bind to",
+							Optional:     true,
+							Default:      "http",
+							ValidateFunc: validateValueFunc([]string{"http", "https"}),
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Description: "IP Address to bind to",
+							Optional:    true,
+							Default:     "*",
+						},
+						"port": {
+							Type:        schema.TypeString,
+							Description: "Port to bind to",
+							Optional:    true,
+							Default:     "80",
+						},
+						"certificate_variable": {
+							Type:        schema.TypeString,
+							Description: "Certificate Variable Name for the SSL Binding",
+							Optional:    true,
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Enable the binding",
+							Optional:    true,
+							Default:     true,
+						},
+					},
+				},
+			},
+			"location": {
+				Type:        schema.TypeList,
+				Description: "The locations the server hosts",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Description: "The location path, e.g. /",
+							Required:    true,
+						},
+						"directives": {
+							Type:        schema.TypeMap,
+							Description: "Nginx directives for the location, e.g. root or index",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"headers": {
+							Type:        schema.TypeMap,
+							Description: "Headers to add to responses from the location",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"reverse_proxy_url": {
+							Type:        schema.TypeString,
+							Description: "Pass requests for the location to this URL",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+type nginxBinding struct {
+	Protocol            string `json:"protocol"`
+	IpAddress           string `json:"ipAddress"`
+	Port                string `json:"port"`
+	CertificateVariable string `json:"certificateVariable"`
+	Enabled             bool   `json:"enabled"`
+}
+
+type nginxLocation struct {
+	Path            string `json:"path"`
+	Directives      string `json:"directives"`
+	Headers         string `json:"headers"`
+	ReverseProxy    string `json:"reverseProxy"`
+	ReverseProxyUrl string `json:"reverseProxyUrl"`
+}
+
+// formatNginxKeyValues serialises the directives or headers of a location, which Octopus stores as a JSON object.
+// Marshalling a map orders its keys, so the property doesn't change between applies.
+func formatNginxKeyValues(tfKeyValues interface{}) string {
+	keyValues := map[string]string{}
+	if tfKeyValues != nil {
+		for key, value := range tfKeyValues.(map[string]interface{}) {
+			keyValues[key] = value.(string)
+		}
+	}
+
+	keyValuesBytes, _ := json.Marshal(keyValues)
+	return string(keyValuesBytes)
+}
+
+func buildNginxBindingsProperty(tfBindings []interface{}) string {
+	bindings := []nginxBinding{}
+	for _, rawBinding := range tfBindings {
+		binding := rawBinding.(map[string]interface{})
+		bindings = append(bindings, nginxBinding{
+			Protocol:            binding["protocol"].(string),
+			IpAddress:           binding["ip"].(string),
+			Port:                binding["port"].(string),
+			CertificateVariable: binding["certificate_variable"].(string),
+			Enabled:             binding["enabled"].(bool),
+		})
+	}
+
+	bindingsBytes, _ := json.Marshal(bindings)
+	return string(bindingsBytes)
+}
+
+func buildNginxLocationsProperty(tfLocations []interface{}) string {
+	locations := []nginxLocation{}
+	for _, rawLocation := range tfLocations {
+		location := rawLocation.(map[string]interface{})
+		reverseProxyUrl := location["reverse_proxy_url"].(string)
+		locations = append(locations, nginxLocation{
+			Path:            location["path"].(string),
+			Directives:      formatNginxKeyValues(location["directives"]),
+			Headers:         formatNginxKeyValues(location["headers"]),
+			ReverseProxy:    formatBool(reverseProxyUrl != ""),
+			ReverseProxyUrl: reverseProxyUrl,
+		})
+	}
+
+	locationsBytes, _ := json.Marshal(locations)
+	return string(locationsBytes)
+}
+
+func flattenNginxBindings(bindingsString string) []interface{} {
+	var bindings []nginxBinding
+	if err := json.Unmarshal([]byte(bindingsString), &bindings); err != nil {
+		return nil
+	}
+
+	tfBindings := []interface{}{}
+	for _, binding := range bindings {
+		tfBindings = append(tfBindings, map[string]interface{}{
+			"protocol":             binding.Protocol,
+			"ip":                   binding.IpAddress,
+			"port":                 binding.Port,
+			"certificate_variable": binding.CertificateVariable,
+			"enabled":              binding.Enabled,
+		})
+	}
+
+	return tfBindings
+}
+
+func flattenNginxLocations(locationsString string) []interface{} {
+	var locations []nginxLocation
+	if err := json.Unmarshal([]byte(locationsString), &locations); err != nil {
+		return nil
+	}
+
+	parseKeyValues := func(value string) map[string]interface{} {
+		keyValues := map[string]string{}
+		json.Unmarshal([]byte(value), &keyValues)
+
+		tfKeyValues := map[string]interface{}{}
+		for key, value := range keyValues {
+			tfKeyValues[key] = value
+		}
+		return tfKeyValues
+	}
+
+	tfLocations := []interface{}{}
+	for _, location := range locations {
+		reverseProxyUrl := ""
+		if parseBoolProperty(location.ReverseProxy) {
+			reverseProxyUrl = location.ReverseProxyUrl
+		}
+
+		tfLocations = append(tfLocations, map[string]interface{}{
+			"path":              location.Path,
+			"directives":        parseKeyValues(location.Directives),
+			"headers":           parseKeyValues(location.Headers),
+			"reverse_proxy_url": reverseProxyUrl,
+		})
+	}
+
+	return tfLocations
+}
+
+// validateNginxBindings checks https bindings, and only https bindings, have a certificate
+func validateNginxBindings(tfBindings []interface{}) error {
+	for i, rawBinding := range tfBindings {
+		binding := rawBinding.(map[string]interface{})
+
+		protocol := binding["protocol"].(string)
+		certificateVariable := binding["certificate_variable"].(string)
+
+		if protocol == hcl2shim.UnknownVariableValue || certificateVariable == hcl2shim.UnknownVariableValue {
+			continue
+		}
+
+		if protocol == "https" && certificateVariable == "" {
+			return fmt.Errorf("nginx binding %d: https bindings require a certificate_variable", i)
+		}
+
+		if protocol != "https" && certificateVariable != "" {
+			return fmt.Errorf("nginx binding %d: certificate_variable is only supported on https bindings", i)
+		}
+	}
+
+	return nil
+}
+
+// addPackageFeaturesToActionResource adds the properties and enabled features of the package feature blocks
+// configured on the action
+func addPackageFeaturesToActionResource(tfAction map[string]interface{}, action octopusdeploy.DeploymentAction) {
+	if tfFeature := getFeature(tfAction, "custom_installation_directory"); tfFeature != nil {
+		action.Properties["Octopus.Action.Package.CustomInstallationDirectory"] = tfFeature["directory"].(string)
+		action.Properties["Octopus.Action.Package.CustomInstallationDirectoryShouldBePurgedBeforeDeployment"] = formatBool(tfFeature["purge_before_deployment"].(bool))
+		action.Properties["Octopus.Action.Package.CustomInstallationDirectoryPurgeExclusions"] = strings.Join(getSliceFromTerraformTypeList(tfFeature["exclude_from_purge"]), "\n")
+		enableFeatures(action.Properties, featureCustomDirectory)
+	}
+
+	if tfFeature := getFeature(tfAction, "structured_configuration_variables"); tfFeature != nil {
+		action.Properties["Octopus.Action.Package.JsonConfigurationVariablesTargets"] = strings.Join(getSliceFromTerraformTypeList(tfFeature["target_files"]), "\n")
+		action.Properties["Octopus.Action.Package.JsonConfigurationVariablesEnabled"] = "True"
+		enableFeatures(action.Properties, featureJsonConfigurationVariables)
+	}
+
+	if tfFeature := getFeature(tfAction, "substitute_in_files"); tfFeature != nil {
+		action.Properties["Octopus.Action.SubstituteInFiles.TargetFiles"] = strings.Join(getSliceFromTerraformTypeList(tfFeature["target_files"]), "\n")
+		action.Properties["Octopus.Action.SubstituteInFiles.Enabled"] = "True"
+		enableFeatures(action.Properties, featureSubstituteInFiles)
+	}
+
+	if tfFeature := getFeature(tfAction, "configuration_transforms"); tfFeature != nil {
+		action.Properties["Octopus.Action.Package.AutomaticallyRunConfigurationTransformationFiles"] = formatBool(tfFeature["automatically_run"].(bool))
+		action.Properties["Octopus.Action.Package.AdditionalXmlConfigurationTransforms"] = tfFeature["additional_transforms"].(string)
+		enableFeatures(action.Properties, featureConfigurationTransforms)
+	}
+
+	if tfFeature := getFeature(tfAction, "configuration_variables"); tfFeature != nil {
+		action.Properties["Octopus.Action.Package.AutomaticallyUpdateAppSettingsAndConnectionStrings"] = formatBool(tfFeature["replace_app_settings_and_connection_strings"].(bool))
+		enableFeatures(action.Properties, featureConfigurationVariables)
+	}
+
+	if tfFeature := getFeature(tfAction, "iis_web_site"); tfFeature != nil {
+		action.Properties["Octopus.Action.IISWebSite.DeploymentType"] = "webSite"
+		action.Properties["Octopus.Action.IISWebSite.CreateOrUpdateWebSite"] = "True"
+		action.Properties["Octopus.Action.IISWebSite.WebApplication.CreateOrUpdate"] = "False"
+		action.Properties["Octopus.Action.IISWebSite.VirtualDirectory.CreateOrUpdate"] = "False"
+		action.Properties["Octopus.Action.IISWebSite.WebRootType"] = "packageRoot"
+		action.Properties["Octopus.Action.IISWebSite.WebSiteName"] = tfFeature["website_name"].(string)
+		action.Properties["Octopus.Action.IISWebSite.ApplicationPoolName"] = tfFeature["application_pool_name"].(string)
+		action.Properties["Octopus.Action.IISWebSite.ApplicationPoolFrameworkVersion"] = tfFeature["application_pool_framework"].(string)
+		action.Properties["Octopus.Action.IISWebSite.ApplicationPoolIdentityType"] = tfFeature["application_pool_identity"].(string)
+		action.Properties["Octopus.Action.IISWebSite.StartWebSite"] = formatBool(tfFeature["start_web_site"].(bool))
+		action.Properties["Octopus.Action.IISWebSite.StartApplicationPool"] = formatBool(tfFeature["start_application_pool"].(bool))
+		action.Properties["Octopus.Action.IISWebSite.EnableAnonymousAuthentication"] = formatBool(tfFeature["anonymous_authentication"].(bool))
+		action.Properties["Octopus.Action.IISWebSite.EnableBasicAuthentication"] = formatBool(tfFeature["basic_authentication"].(bool))
+		action.Properties["Octopus.Action.IISWebSite.EnableWindowsAuthentication"] = formatBool(tfFeature["windows_authentication"].(bool))
+		action.Properties["Octopus.Action.IISWebSite.Bindings"] = buildIisBindingsProperty(tfFeature["binding"].([]interface{}))
+		enableFeatures(action.Properties, featureIISWebSite)
+	}
+
+	if tfFeature := getFeature(tfAction, "nginx"); tfFeature != nil {
+		action.Properties["Octopus.Action.Nginx.Server.HostName"] = tfFeature["host_name"].(string)
+		action.Properties["Octopus.Action.Nginx.Server.Bindings"] = buildNginxBindingsProperty(tfFeature["binding"].([]interface{}))
+		action.Properties["Octopus.Action.Nginx.Server.Locations"] = buildNginxLocationsProperty(tfFeature["location"].([]interface{}))
+		enableFeatures(action.Properties, featureNginx)
+	}
+
+	addCustomScriptsToProperties(tfAction["custom_scripts"], action.Properties)
+}
+
+// flattenPackageFeatures sets the package feature blocks of the action from the features enabled in Octopus.
+// Blocks are only set for features the action has in its schema.
+func flattenPackageFeatures(tfAction map[string]interface{}, properties map[string]string) {
+	setFeature := func(name string, feature string, flattened map[string]interface{}) {
+		if _, ok := tfAction[name]; !ok {
+			return
+		}
+
+		if isFeatureEnabled(properties, feature) {
+			tfAction[name] = []interface{}{flattened}
+		} else {
+			tfAction[name] = []interface{}{}
+		}
+	}
+
+	if _, ok := tfAction["custom_scripts"]; ok {
+		tfAction["custom_scripts"] = flattenCustomScripts(properties)
+	}
+
+	setFeature("custom_installation_directory", featureCustomDirectory, map[string]interface{}{
+		"directory":               properties["Octopus.Action.Package.CustomInstallationDirectory"],
+		"purge_before_deployment": parseBoolProperty(properties["Octopus.Action.Package.CustomInstallationDirectoryShouldBePurgedBeforeDeployment"]),
+		"exclude_from_purge":      splitFeatureTargetFiles(properties["Octopus.Action.Package.CustomInstallationDirectoryPurgeExclusions"]),
+	})
+
+	setFeature("structured_configuration_variables", featureJsonConfigurationVariables, map[string]interface{}{
+		"target_files": splitFeatureTargetFiles(properties["Octopus.Action.Package.JsonConfigurationVariablesTargets"]),
+	})
+
+	setFeature("substitute_in_files", featureSubstituteInFiles, map[string]interface{}{
+		"target_files": splitFeatureTargetFiles(properties["Octopus.Action.SubstituteInFiles.TargetFiles"]),
+	})
+
+	setFeature("configuration_transforms", featureConfigurationTransforms, map[string]interface{}{
+		"automatically_run":     parseBoolProperty(properties["Octopus.Action.Package.AutomaticallyRunConfigurationTransformationFiles"]),
+		"additional_transforms": properties["Octopus.Action.Package.AdditionalXmlConfigurationTransforms"],
+	})
+
+	setFeature("configuration_variables", featureConfigurationVariables, map[string]interface{}{
+		"replace_app_settings_and_connection_strings": parseBoolProperty(properties["Octopus.Action.Package.AutomaticallyUpdateAppSettingsAndConnectionStrings"]),
+	})
+
+	var tfIisBindings []interface{}
+	if tfFeatures, ok := tfAction["iis_web_site"].([]interface{}); ok && len(tfFeatures) > 0 && tfFeatures[0] != nil {
+		tfIisBindings, _ = tfFeatures[0].(map[string]interface{})["binding"].([]interface{})
+	}
+
+	iisBindings, _ := flattenConfiguredIisBindings(properties["Octopus.Action.IISWebSite.Bindings"], tfIisBindings)
+	setFeature("iis_web_site", featureIISWebSite, map[string]interface{}{
+		"website_name":               properties["Octopus.Action.IISWebSite.WebSiteName"],
+		"application_pool_name":      properties["Octopus.Action.IISWebSite.ApplicationPoolName"],
+		"application_pool_framework": properties["Octopus.Action.IISWebSite.ApplicationPoolFrameworkVersion"],
+		"application_pool_identity":  properties["Octopus.Action.IISWebSite.ApplicationPoolIdentityType"],
+		"start_web_site":             parseBoolProperty(properties["Octopus.Action.IISWebSite.StartWebSite"]),
+		"start_application_pool":     parseBoolProperty(properties["Octopus.Action.IISWebSite.StartApplicationPool"]),
+		"anonymous_authentication":   parseBoolProperty(properties["Octopus.Action.IISWebSite.EnableAnonymousAuthentication"]),
+		"basic_authentication":       parseBoolProperty(properties["Octopus.Action.IISWebSite.EnableBasicAuthentication"]),
+		"windows_authentication":     parseBoolProperty(properties["Octopus.Action.IISWebSite.EnableWindowsAuthentication"]),
+		"binding":                    iisBindings,
+	})
+
+	setFeature("nginx", featureNginx, map[string]interface{}{
+		"host_name": properties["Octopus.Action.Nginx.Server.HostName"],
+		"binding":   flattenNginxBindings(properties["Octopus.Action.Nginx.Server.Bindings"]),
+		"location":  flattenNginxLocations(properties["Octopus.Action.Nginx.Server.Locations"]),
+	})
+}
+
+// customScriptExtensions maps the syntax of a custom deployment script to the extension Octopus uses in the
+// Octopus.Action.CustomScripts.<Phase>.<extension> property
+var customScriptExtensions = map[string]string{
+	"PowerShell": "ps1",
+	"CSharp":     "csx",
+	"Bash":       "sh",
+	"FSharp":     "fsx",
+	"Python":     "py",
+}
+
+// customScriptPhases maps the blocks of custom_scripts to the phase names Octopus uses
+var customScriptPhases = map[string]string{
+	"pre_deploy":  "PreDeploy",
+	"deploy":      "Deploy",
+	"post_deploy": "PostDeploy",
+}
+
+func getCustomScriptsSchema() *schema.Schema {
+	phaseSchema := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Description: description,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"syntax": {
+						Type:         schema.TypeString,
+						Description:  "The scripting language of the script",
+						Optional:     true,
+						Default:      "PowerShell",
+						ValidateFunc: validateValueFunc(scriptSyntaxes),
+					},
+					"body": {
+						Type:        schema.TypeString,
+						Description: "The script body",
+						Required:    true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Schema{
+		Description: "Scripts to run before, during and after the package is deployed",
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"pre_deploy":  phaseSchema("Runs before the package is extracted and configured"),
+				"deploy":      phaseSchema("Runs after the package is extracted and configured"),
+				"post_deploy": phaseSchema("Runs after the package has been deployed"),
+			},
+		},
+	}
+}
+
+func addCustomDeploymentScriptsFeature(parent *schema.Resource) {
+	parent.Schema["custom_scripts"] = getCustomScriptsSchema()
+}
+
+// addCustomScriptsToProperties adds the custom_scripts block to the properties of an action
+func addCustomScriptsToProperties(tfCustomScripts interface{}, properties map[string]string) {
+	if tfCustomScripts == nil {
+		return
+	}
+
+	tfCustomScriptsList := tfCustomScripts.([]interface{})
+	if len(tfCustomScriptsList) == 0 || tfCustomScriptsList[0] == nil {
+		return
+	}
+
+	phases := tfCustomScriptsList[0].(map[string]interface{})
+	for block, phase := range customScriptPhases {
+		tfScripts := phases[block].([]interface{})
+		if len(tfScripts) == 0 || tfScripts[0] == nil {
+			continue
+		}
+
+		tfScript := tfScripts[0].(map[string]interface{})
+		extension := customScriptExtensions[tfScript["syntax"].(string)]
+		properties[fmt.Sprintf("Octopus.Action.CustomScripts.%s.%s", phase, extension)] = tfScript["body"].(string)
+		enableFeatures(properties, featureCustomScripts)
+	}
+}
+
+// flattenCustomScripts reads the custom_scripts block back from the properties of an action
+func flattenCustomScripts(properties map[string]string) []interface{} {
+	if !isFeatureEnabled(properties, featureCustomScripts) {
+		return nil
+	}
+
+	phases := map[string]interface{}{}
+	found := false
+
+	for block, phase := range customScriptPhases {
+		phases[block] = []interface{}{}
+
+		/* Look the syntaxes up in a fixed order, so a phase with scripts in several syntaxes reads back the same one each time */
+		for _, syntax := range scriptSyntaxes {
+			extension := customScriptExtensions[syntax]
+			if body, ok := properties[fmt.Sprintf("Octopus.Action.CustomScripts.%s.%s", phase, extension)]; ok && body != "" {
+				phases[block] = []interface{}{map[string]interface{}{
+					"syntax": syntax,
+					"body":   body,
+				}}
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	return []interface{}{phases}
+}
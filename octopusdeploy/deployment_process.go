@@ -2,9 +2,10 @@ package octopusdeploy
 
 import (
 	"fmt"
-	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
-	"github.com/hashicorp/terraform/helper/schema"
 	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
 )
 
 func resourceDeploymentProcess() *schema.Resource {
@@ -14,6 +15,8 @@ func resourceDeploymentProcess() *schema.Resource {
 		Update: resourceDeploymentProcessUpdate,
 		Delete: resourceDeploymentProcessDelete,
 
+		CustomizeDiff: resourceDeploymentProcessCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
@@ -25,7 +28,11 @@ func resourceDeploymentProcess() *schema.Resource {
 }
 
 func resourceDeploymentProcessCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
+
+	if err := validateDeploymentProcessTargetRoles(d); err != nil {
+		return err
+	}
 
 	newDeploymentProcess := buildDeploymentProcessResource(d)
 
@@ -55,26 +62,34 @@ func resourceDeploymentProcessCreate(d *schema.ResourceData, m interface{}) erro
 func buildDeploymentProcessResource(d *schema.ResourceData) *octopusdeploy.DeploymentProcess {
 	deploymentProcess := &octopusdeploy.DeploymentProcess{
 		ProjectID: d.Get("project_id").(string),
+		Steps:     buildDeploymentProcessSteps(d),
 	}
 
+	return deploymentProcess
+}
+
+// buildDeploymentProcessSteps returns the steps of a process resource, deployment or runbook
+func buildDeploymentProcessSteps(d *schema.ResourceData) []octopusdeploy.DeploymentStep {
+	var steps []octopusdeploy.DeploymentStep
+
 	if attr, ok := d.GetOk("step"); ok {
 		tfSteps := attr.([]interface{})
 
 		for _, tfStep := range tfSteps {
 			step := buildDeploymentStepResource(tfStep.(map[string]interface{}))
-			deploymentProcess.Steps = append(deploymentProcess.Steps, step)
+			steps = append(steps, step)
 		}
 	}
 
-	return deploymentProcess
+	return steps
 }
 
 func resourceDeploymentProcessRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	deploymentProcessID := d.Id()
 
-	_, err := client.DeploymentProcess.Get(deploymentProcessID)
+	deploymentProcess, err := client.DeploymentProcess.Get(deploymentProcessID)
 
 	if err == octopusdeploy.ErrItemNotFound {
 		d.SetId("")
@@ -87,14 +102,81 @@ func resourceDeploymentProcessRead(d *schema.ResourceData, m interface{}) error
 
 	log.Printf("[DEBUG] deploymentProcess: %v", m)
 
+	if err := setDeploymentProcessSteps(d, deploymentProcess.Steps); err != nil {
+		return fmt.Errorf("error setting steps of deployment process id %s: %s", deploymentProcessID, err.Error())
+	}
+
+	return nil
+}
+
+// setDeploymentProcessSteps reads the steps of a process, deployment or runbook, back into the configured steps
+// with the same name
+func setDeploymentProcessSteps(d *schema.ResourceData, processSteps []octopusdeploy.DeploymentStep) error {
+	if attr, ok := d.GetOk("step"); ok {
+		steps := map[string]octopusdeploy.DeploymentStep{}
+		for _, step := range processSteps {
+			steps[step.Name] = step
+		}
+
+		tfSteps := attr.([]interface{})
+		for _, tfStep := range tfSteps {
+			tfStepMap := tfStep.(map[string]interface{})
+			if step, ok := steps[tfStepMap["name"].(string)]; ok {
+				flattenDeploymentStep(tfStepMap, step)
+			}
+		}
+
+		return d.Set("step", tfSteps)
+	}
+
+	return nil
+}
+
+func resourceDeploymentProcessCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if attr, ok := d.GetOk("step"); ok {
+		for i, tfStep := range attr.([]interface{}) {
+			if err := validateDeploymentStep(tfStep.(map[string]interface{})); err != nil {
+				return err
+			}
+
+			/* target_roles computed during apply are checked by Create and Update once they are known */
+			if d.NewValueKnown(fmt.Sprintf("step.%d.target_roles", i)) {
+				if err := validateDeploymentStepTargetRoles(tfStep.(map[string]interface{})); err != nil {
+					return err
+				}
+			}
+
+			if err := validateDeploymentStepReferences(m.(*providerMeta).Client, tfStep.(map[string]interface{})); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateDeploymentProcessTargetRoles checks the target roles of the steps on apply, for those not known when planning
+func validateDeploymentProcessTargetRoles(d *schema.ResourceData) error {
+	if attr, ok := d.GetOk("step"); ok {
+		for _, tfStep := range attr.([]interface{}) {
+			if err := validateDeploymentStepTargetRoles(tfStep.(map[string]interface{})); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
 func resourceDeploymentProcessUpdate(d *schema.ResourceData, m interface{}) error {
+	if err := validateDeploymentProcessTargetRoles(d); err != nil {
+		return err
+	}
+
 	deploymentProcess := buildDeploymentProcessResource(d)
 	deploymentProcess.ID = d.Id() // set deploymentProcess struct ID so octopus knows which deploymentProcess to update
 
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	current, err := client.DeploymentProcess.Get(deploymentProcess.ID)
 	if err != nil {
@@ -114,7 +196,7 @@ func resourceDeploymentProcessUpdate(d *schema.ResourceData, m interface{}) erro
 }
 
 func resourceDeploymentProcessDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 	current, err := client.DeploymentProcess.Get(d.Id())
 
 	if err != nil {
@@ -2,10 +2,14 @@ package octopusdeploy
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -25,6 +29,288 @@ func TestAccOctopusDeployDeploymentProcessBasic(t *testing.T) {
 	})
 }
 
+func TestAccOctopusDeployDeploymentProcessChildActions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBuildTestAction(`
+					window_size = "2"
+
+					child_action {
+						action {
+							name = "First"
+							action_type = "Octopus.Script"
+
+							property {
+								key = "Octopus.Action.Script.ScriptBody"
+								value = "Write-Host 'First'"
+							}
+						}
+					}
+
+					child_action {
+						run_script_action {
+							name = "Second"
+							script_body = "Write-Host 'Second'"
+						}
+					}
+
+					child_action {
+						manual_intervention_action {
+							name = "Third"
+							instructions = "Approve the deployment"
+						}
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOctopusDeployDeploymentProcessActionOrder("First", "Second", "Third"),
+					resource.TestCheckResourceAttr(
+						"octopusdeploy_deployment_process.test", "step.0.window_size", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployDeploymentProcessInvalidChildActions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBuildTestAction(`
+					child_action {
+						run_script_action {
+							name = "First"
+							script_body = "Write-Host 'First'"
+						}
+
+						run_script_action {
+							name = "Second"
+							script_body = "Write-Host 'Second'"
+						}
+					}
+				`),
+				ExpectError: regexp.MustCompile("child_action 0 must hold exactly one action block, it holds 2"),
+			},
+			{
+				Config: testAccBuildTestStep(`
+					run_script_action {
+						name = "First"
+						script_body = "Write-Host 'First'"
+					}
+
+					run_script_action {
+						name = "Second"
+						script_body = "Write-Host 'Second'"
+					}
+				`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("steps with more than one action run them as child steps, and need target_roles"),
+			},
+			{
+				Config: testAccBuildTestAction(`
+					run_script_action {
+						name = "First"
+						script_body = "Write-Host 'First'"
+					}
+
+					action {
+						name = "Second"
+						action_type = "Octopus.Script"
+
+						property {
+							key = "Octopus.Action.TargetRoles"
+							value = "Other"
+						}
+					}
+				`),
+				ExpectError: regexp.MustCompile("can't set its own target roles"),
+			},
+			{
+				Config: testAccBuildTestAction(`
+					window_size = "none"
+
+					run_script_action {
+						name = "First"
+						script_body = "Write-Host 'First'"
+					}
+				`),
+				ExpectError: regexp.MustCompile("window_size must be a positive number or a variable expression"),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployDeploymentProcessComputedRollingDeployment(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				// target_roles and window_size aren't known until the project group is created
+				Config: testAccDeploymentProcessComputedRollingDeployment(`["WebServer"]`, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"octopusdeploy_deployment_process.test", "step.0.target_roles.0", "WebServer"),
+					resource.TestCheckResourceAttr(
+						"octopusdeploy_deployment_process.test", "step.0.window_size", "2"),
+				),
+			},
+			{
+				Config:      testAccDeploymentProcessComputedRollingDeployment(`[]`, `["WebServer"]`),
+				ExpectError: regexp.MustCompile("window_size needs target_roles to roll out across"),
+			},
+		},
+	})
+}
+
+// testAccDeploymentProcessComputedRollingDeployment sets the target roles of a rolling deployment to the
+// first list given once the project group has been created
+func TestValidateDeploymentStepChildActions(t *testing.T) {
+	cases := []struct {
+		action map[string]interface{}
+		err    string
+	}{
+		{map[string]interface{}{"name": "Script", "script_body": "Write-Host 'Hello'"}, ""},
+		{map[string]interface{}{"name": "Script", "script_body": "Write-Host 'Hello'", "script_file_name": "Hello.ps1"}, "only one of script_body and script_file_name can be set"},
+		{map[string]interface{}{"name": "Script"}, "one of script_body or script_file_name must be set"},
+	}
+
+	for _, c := range cases {
+		tfStep := testDeploymentStep(t, map[string]interface{}{
+			"name":         "Step",
+			"target_roles": []interface{}{"Web"},
+			"child_action": []interface{}{
+				map[string]interface{}{
+					"run_script_action": []interface{}{c.action},
+				},
+			},
+		})
+
+		err := validateDeploymentStep(tfStep)
+		if c.err == "" && err != nil {
+			t.Errorf("%v: expected no error, got %s", c.action, err)
+		}
+		if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+			t.Errorf("%v: expected an error containing %q, got %v", c.action, c.err, err)
+		}
+	}
+}
+
+func TestFlattenDeploymentStepChildActions(t *testing.T) {
+	tfStep := testDeploymentStep(t, map[string]interface{}{
+		"name":         "Step",
+		"target_roles": []interface{}{"Web"},
+		"child_action": []interface{}{
+			map[string]interface{}{
+				"run_script_action": []interface{}{
+					map[string]interface{}{"name": "First", "script_body": "Write-Host 'First'"},
+				},
+			},
+			map[string]interface{}{
+				"run_script_action": []interface{}{
+					map[string]interface{}{"name": "Removed", "script_body": "Write-Host 'Removed'"},
+				},
+			},
+		},
+	})
+
+	flattenDeploymentStep(tfStep, octopusdeploy.DeploymentStep{
+		Name: "Step",
+		Actions: []octopusdeploy.DeploymentAction{
+			{Name: "Added", ActionType: "Octopus.Email"},
+			{Name: "First", ActionType: "Octopus.Script", Properties: map[string]string{"Octopus.Action.Script.ScriptBody": "Write-Host 'First'"}},
+		},
+	})
+
+	var names []string
+	for _, tfChildAction := range getChildActions(tfStep) {
+		block, tfAction := getChildActionBlock(tfChildAction)
+		names = append(names, fmt.Sprintf("%s %s", block.name, tfAction["name"]))
+	}
+
+	if expected := []string{"action Added", "run_script_action First"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected child actions %v, got %v", expected, names)
+	}
+}
+
+// testDeploymentStep returns a step of a deployment process, with the defaults of the attributes raw leaves out
+func testDeploymentStep(t *testing.T, raw map[string]interface{}) map[string]interface{} {
+	d := schema.TestResourceDataRaw(t, resourceDeploymentProcess().Schema, map[string]interface{}{
+		"project_id": "Projects-1",
+		"step":       []interface{}{raw},
+	})
+
+	return d.Get("step").([]interface{})[0].(map[string]interface{})
+}
+
+func testAccDeploymentProcessComputedRollingDeployment(targetRoles string, otherTargetRoles string) string {
+	return fmt.Sprintf(`
+		resource "octopusdeploy_lifecycle" "test" {
+			name = "Test Lifecycle"
+		}
+
+		resource "octopusdeploy_project_group" "test" {
+			name = "Test Group"
+		}
+
+		resource "octopusdeploy_project" "test" {
+			name             = "Test Project"
+			lifecycle_id     = "${octopusdeploy_lifecycle.test.id}"
+			project_group_id = "${octopusdeploy_project_group.test.id}"
+		}
+
+		resource "octopusdeploy_deployment_process" "test" {
+			project_id = "${octopusdeploy_project.test.id}"
+
+			step {
+				name = "Test"
+				target_roles = octopusdeploy_project_group.test.id != "" ? %s : %s
+				window_size = replace(octopusdeploy_project_group.test.id, "/.*/", "2")
+
+				run_script_action {
+					name = "First"
+					script_body = "Write-Host 'First'"
+				}
+
+				run_script_action {
+					name = "Second"
+					script_body = "Write-Host 'Second'"
+				}
+			}
+		}
+		`, targetRoles, otherTargetRoles)
+}
+
+func testAccCheckOctopusDeployDeploymentProcessActionOrder(expectedNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		actions := process.Steps[0].Actions
+		if len(actions) != len(expectedNames) {
+			return fmt.Errorf("Step has %d actions instead of the expected %d", len(actions), len(expectedNames))
+		}
+
+		for i, action := range actions {
+			if action.Name != expectedNames[i] {
+				return fmt.Errorf("Action %d is %s, expected %s", i, action.Name, expectedNames[i])
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccDeploymentProcessBasic() string {
 	return `
 		resource "octopusdeploy_lifecycle" "test" {
@@ -131,6 +417,14 @@ func testAccDeploymentProcessBasic() string {
 }
 
 func testAccBuildTestAction(action string) string {
+	return testAccBuildTestStep(`
+				target_roles = ["WebServer"]
+
+				` + action)
+}
+
+// testAccBuildTestStep builds a deployment process with a single step holding the given configuration
+func testAccBuildTestStep(step string) string {
 	return fmt.Sprintf(`
 		resource "octopusdeploy_lifecycle" "test" {
 			name = "Test Lifecycle"
@@ -151,16 +445,14 @@ func testAccBuildTestAction(action string) string {
 
 			step {
 				name = "Test"
-				target_roles = ["WebServer"]
-
 				%s
 			}
 		}
-		`, action)
+		`, step)
 }
 
 func testAccCheckOctopusDeployDeploymentProcessDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	client := testAccProvider.Meta().(*providerMeta).Client
 
 	if err := destroyProjectHelper(s, client); err != nil {
 		return err
@@ -176,7 +468,7 @@ func testAccCheckOctopusDeployDeploymentProcessDestroy(s *terraform.State) error
 
 func testAccCheckOctopusDeployDeploymentProcess() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 
 		process, err := getDeploymentProcess(s, client)
 		if err != nil {
@@ -1,13 +1,57 @@
 package octopusdeploy
 
 import (
-	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
-	"github.com/hashicorp/terraform/helper/schema"
+	"fmt"
+	"regexp"
 	"strings"
+
+	"github.com/hashicorp/terraform/config/hcl2shim"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
 )
 
+// deploymentActionBlock is a type of action block a step can hold, with the function building its action
+type deploymentActionBlock struct {
+	name   string
+	schema func() *schema.Schema
+	build  func(tfAction map[string]interface{}) octopusdeploy.DeploymentAction
+}
+
+// deploymentActionBlocks are the action blocks of a step, in the order their actions are added to the step
+var deploymentActionBlocks = []deploymentActionBlock{
+	{"action", getDeploymentActionSchema, buildDeploymentActionResource},
+	{"manual_intervention_action", getManualInterventionActionSchema, buildManualInterventionActionResource},
+	{"apply_terraform_action", getTerraformActionSchema, buildApplyTerraformActionResource},
+	{"plan_terraform_action", getTerraformActionSchema, buildPlanTerraformActionResource},
+	{"destroy_terraform_action", getTerraformActionSchema, buildDestroyTerraformActionResource},
+	{"plan_destroy_terraform_action", getTerraformActionSchema, buildPlanDestroyTerraformActionResource},
+	{"deploy_package_action", getDeployPackageAction, buildDeployPackageActionResource},
+	{"deploy_windows_service_action", getDeployWindowsServiceActionSchema, buildDeployWindowsServiceActionResource},
+	{"run_script_action", getRunScriptActionSchema, buildRunScriptActionResource},
+	{"run_kubectl_script_action", getRunRunKubectlScriptSchema, buildRunKubectlScriptActionResource},
+	{"deploy_kubernetes_secret_action", getDeployKubernetesSecretActionSchema, buildDeployKubernetesSecretActionResource},
+	{"deploy_kubernetes_containers_action", getDeployKubernetesContainersActionSchema, buildDeployKubernetesContainersActionResource},
+	{"upgrade_helm_chart_action", getUpgradeHelmChartActionSchema, buildUpgradeHelmChartActionResource},
+	{"deploy_raw_kubernetes_yaml_action", getDeployRawKubernetesYamlActionSchema, buildDeployRawKubernetesYamlActionResource},
+	{"deploy_kubernetes_config_map_action", getDeployKubernetesConfigMapActionSchema, buildDeployKubernetesConfigMapActionResource},
+	{"deploy_aws_cloudformation_action", getDeployAwsCloudFormationActionSchema, buildDeployAwsCloudFormationActionResource},
+	{"delete_aws_cloudformation_action", getDeleteAwsCloudFormationActionSchema, buildDeleteAwsCloudFormationActionResource},
+	{"upload_aws_s3_action", getUploadAwsS3ActionSchema, buildUploadAwsS3ActionResource},
+	{"run_aws_cli_script_action", getRunAwsCliScriptActionSchema, buildRunAwsCliScriptActionResource},
+	{"deploy_azure_web_app_action", getDeployAzureWebAppActionSchema, buildDeployAzureWebAppActionResource},
+	{"deploy_azure_resource_group_action", getDeployAzureResourceGroupActionSchema, buildDeployAzureResourceGroupActionResource},
+	{"run_azure_script_action", getRunAzureScriptActionSchema, buildRunAzureScriptActionResource},
+	{"deploy_java_archive_action", getDeployJavaArchiveActionSchema, buildDeployJavaArchiveActionResource},
+	{"deploy_tomcat_action", getDeployTomcatActionSchema, buildDeployTomcatActionResource},
+	{"tomcat_state_action", getTomcatStateActionSchema, buildTomcatStateActionResource},
+	{"configure_tomcat_certificate_action", getConfigureTomcatCertificateActionSchema, buildConfigureTomcatCertificateActionResource},
+	{"deploy_wildfly_action", getDeployWildFlyActionSchema, buildDeployWildFlyActionResource},
+	{"wildfly_state_action", getWildFlyStateActionSchema, buildWildFlyStateActionResource},
+	{"configure_wildfly_certificate_action", getConfigureWildFlyCertificateActionSchema, buildConfigureWildFlyCertificateActionResource},
+}
+
 func getDeploymentStepSchema() *schema.Schema {
-	return &schema.Schema{
+	stepSchema := &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
 		Elem: &schema.Resource{
@@ -68,17 +112,37 @@ func getDeploymentStepSchema() *schema.Schema {
 					Description: "The maximum number of targets to deploy to simultaneously",
 					Optional:    true,
 				},
-				"action":                          getDeploymentActionSchema(),
-				"manual_intervention_action":      getManualInterventionActionSchema(),
-				"apply_terraform_action":          getApplyTerraformActionSchema(),
-				"deploy_package_action":           getDeployPackageAction(),
-				"deploy_windows_service_action":   getDeployWindowsServiceActionSchema(),
-				"run_script_action":               getRunScriptActionSchema(),
-				"run_kubectl_script_action":       getRunRunKubectlScriptSchema(),
-				"deploy_kubernetes_secret_action": getDeployKubernetesSecretActionSchema(),
+				"child_action": getChildActionSchema(),
 			},
 		},
 	}
+
+	for _, block := range deploymentActionBlocks {
+		stepSchema.Elem.(*schema.Resource).Schema[block.name] = block.schema()
+	}
+
+	return stepSchema
+}
+
+// getChildActionSchema returns the ordered list of child actions of a step, each holding one action block.
+// Terraform doesn't keep the order of blocks of different types, so actions of different types only run in
+// the order they are written when they are child actions.
+func getChildActionSchema() *schema.Schema {
+	childSchema := map[string]*schema.Schema{}
+	for _, block := range deploymentActionBlocks {
+		blockSchema := block.schema()
+		blockSchema.MaxItems = 1
+		childSchema[block.name] = blockSchema
+	}
+
+	return &schema.Schema{
+		Description: "The actions of the step in the order they run, each holding a single action block. Actions in blocks directly on the step run first, in the order of their block types",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: childSchema,
+		},
+	}
 }
 
 func buildDeploymentStepResource(tfStep map[string]interface{}) octopusdeploy.DeploymentStep {
@@ -105,61 +169,324 @@ func buildDeploymentStepResource(tfStep map[string]interface{}) octopusdeploy.De
 		step.Properties["Octopus.Action.MaxParallelism"] = windowSize.(string)
 	}
 
-	if attr, ok := tfStep["action"]; ok {
-		for _, tfAction := range attr.([]interface{}) {
-			action := buildDeploymentActionResource(tfAction.(map[string]interface{}))
-			step.Actions = append(step.Actions, action)
+	for _, block := range deploymentActionBlocks {
+		if attr, ok := tfStep[block.name]; ok && attr != nil {
+			for _, tfAction := range attr.([]interface{}) {
+				step.Actions = append(step.Actions, block.build(tfAction.(map[string]interface{})))
+			}
+		}
+	}
+
+	for _, tfChildAction := range getChildActions(tfStep) {
+		if block, tfAction := getChildActionBlock(tfChildAction); tfAction != nil {
+			step.Actions = append(step.Actions, block.build(tfAction))
 		}
 	}
 
-	if attr, ok := tfStep["manual_intervention_action"]; ok {
-		for _, tfAction := range attr.([]interface{}) {
-			action := buildManualInterventionActionResource(tfAction.(map[string]interface{}))
-			step.Actions = append(step.Actions, action)
+	return step
+}
+
+// getChildActions returns the child_action blocks of a step
+func getChildActions(tfStep map[string]interface{}) []map[string]interface{} {
+	tfChildActions := []map[string]interface{}{}
+	if attr, ok := tfStep["child_action"]; ok && attr != nil {
+		for _, tfChildAction := range attr.([]interface{}) {
+			if tfChildAction != nil {
+				tfChildActions = append(tfChildActions, tfChildAction.(map[string]interface{}))
+			}
 		}
 	}
 
-	if attr, ok := tfStep["apply_terraform_action"]; ok {
-		for _, tfAction := range attr.([]interface{}) {
-			action := buildApplyTerraformActionResource(tfAction.(map[string]interface{}))
-			step.Actions = append(step.Actions, action)
+	return tfChildActions
+}
+
+// getChildActionBlock returns the action block a child action holds, or nil when it holds none
+func getChildActionBlock(tfChildAction map[string]interface{}) (deploymentActionBlock, map[string]interface{}) {
+	for _, block := range deploymentActionBlocks {
+		if attr, ok := tfChildAction[block.name]; ok && attr != nil {
+			for _, tfAction := range attr.([]interface{}) {
+				if tfAction != nil {
+					return block, tfAction.(map[string]interface{})
+				}
+			}
 		}
 	}
 
-	if attr, ok := tfStep["deploy_package_action"]; ok {
-		for _, tfAction := range attr.([]interface{}) {
-			action := buildDeployPackageActionResource(tfAction.(map[string]interface{}))
-			step.Actions = append(step.Actions, action)
+	return deploymentActionBlock{}, nil
+}
+
+// getDeploymentStepActions returns the actions of a step, in the order they are built
+func getDeploymentStepActions(tfStep map[string]interface{}) []map[string]interface{} {
+	tfActions := []map[string]interface{}{}
+	forEachDeploymentStepAction(tfStep, func(block string, tfAction map[string]interface{}) error {
+		tfActions = append(tfActions, tfAction)
+		return nil
+	})
+
+	return tfActions
+}
+
+// forEachDeploymentStepAction calls f with each action of a step, in the order they are built, and the name of
+// the block holding it. It stops at the first error f returns.
+func forEachDeploymentStepAction(tfStep map[string]interface{}, f func(block string, tfAction map[string]interface{}) error) error {
+	for _, block := range deploymentActionBlocks {
+		if attr, ok := tfStep[block.name]; ok && attr != nil {
+			for _, tfAction := range attr.([]interface{}) {
+				if err := f(block.name, tfAction.(map[string]interface{})); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
-	if attr, ok := tfStep["deploy_windows_service_action"]; ok {
-		for _, tfAction := range attr.([]interface{}) {
-			action := buildDeployWindowsServiceActionResource(tfAction.(map[string]interface{}))
-			step.Actions = append(step.Actions, action)
+	for _, tfChildAction := range getChildActions(tfStep) {
+		if block, tfAction := getChildActionBlock(tfChildAction); tfAction != nil {
+			if err := f(block.name, tfAction); err != nil {
+				return err
+			}
 		}
 	}
 
-	if attr, ok := tfStep["run_script_action"]; ok {
-		for _, tfAction := range attr.([]interface{}) {
-			action := buildRunScriptActionResource(tfAction.(map[string]interface{}))
-			step.Actions = append(step.Actions, action)
+	return nil
+}
+
+var windowSizeRegex = regexp.MustCompile(`^([1-9][0-9]*|#\{.+\})$`)
+
+// validateDeploymentStepActions checks the step's actions can be told apart by name, that each child_action
+// holds one action, and the rules of steps that run child actions or roll out across targets. Values not known
+// until apply are not checked.
+func validateDeploymentStepActions(tfStep map[string]interface{}) error {
+	stepName := tfStep["name"].(string)
+
+	for i, tfChildAction := range getChildActions(tfStep) {
+		count := 0
+		for _, block := range deploymentActionBlocks {
+			if attr, ok := tfChildAction[block.name]; ok && attr != nil {
+				count += len(attr.([]interface{}))
+			}
+		}
+		if count != 1 {
+			return fmt.Errorf("step %s: child_action %d must hold exactly one action block, it holds %d", stepName, i, count)
 		}
 	}
 
-	if attr, ok := tfStep["run_kubectl_script_action"]; ok {
-		for _, tfAction := range attr.([]interface{}) {
-			action := buildRunKubectlScriptActionResource(tfAction.(map[string]interface{}))
-			step.Actions = append(step.Actions, action)
+	tfActions := getDeploymentStepActions(tfStep)
+
+	names := map[string]bool{}
+	for _, tfAction := range tfActions {
+		name := tfAction["name"].(string)
+		if name == hcl2shim.UnknownVariableValue {
+			continue
+		}
+		if names[name] {
+			return fmt.Errorf("step %s: action names must be unique, %s is used more than once", stepName, name)
 		}
+		names[name] = true
 	}
 
-	if attr, ok := tfStep["deploy_kubernetes_secret_action"]; ok {
-		for _, tfAction := range attr.([]interface{}) {
-			action := buildDeployKubernetesSecretActionResource(tfAction.(map[string]interface{}))
-			step.Actions = append(step.Actions, action)
+	if len(tfActions) > 1 {
+		for _, tfAction := range tfActions {
+			if tfProperties, ok := tfAction["property"]; ok && tfProperties != nil {
+				if _, ok := buildPropertiesMap(tfProperties)["Octopus.Action.TargetRoles"]; ok {
+					return fmt.Errorf("step %s: child action %s can't set its own target roles, they are set by the step", stepName, tfAction["name"])
+				}
+			}
 		}
 	}
 
-	return step
+	windowSize, _ := tfStep["window_size"].(string)
+	if windowSize != "" && windowSize != hcl2shim.UnknownVariableValue && !windowSizeRegex.MatchString(windowSize) {
+		return fmt.Errorf("step %s: window_size must be a positive number or a variable expression", stepName)
+	}
+
+	return nil
+}
+
+// validateDeploymentStepTargetRoles checks steps that run child actions or roll out across targets have
+// target_roles. A target_roles list computed during apply reads as empty, so callers only check it at plan
+// time when it is known, and check it again on apply.
+func validateDeploymentStepTargetRoles(tfStep map[string]interface{}) error {
+	stepName := tfStep["name"].(string)
+
+	if len(getSliceFromTerraformTypeList(tfStep["target_roles"])) > 0 {
+		return nil
+	}
+
+	if len(getDeploymentStepActions(tfStep)) > 1 {
+		return fmt.Errorf("step %s: steps with more than one action run them as child steps, and need target_roles", stepName)
+	}
+
+	if windowSize, _ := tfStep["window_size"].(string); windowSize != "" {
+		return fmt.Errorf("step %s: window_size needs target_roles to roll out across", stepName)
+	}
+
+	return nil
+}
+
+// actionValidateFuncs are the action blocks that check their configuration at plan time
+var actionValidateFuncs = map[string]func(tfAction map[string]interface{}) error{
+	"run_script_action":                    validateScriptSource,
+	"run_kubectl_script_action":            validateScriptSource,
+	"upgrade_helm_chart_action":            validateHelmValuesSources,
+	"deploy_raw_kubernetes_yaml_action":    validateRawKubernetesYamlSource,
+	"deploy_aws_cloudformation_action":     validateDeployAwsCloudFormationAction,
+	"delete_aws_cloudformation_action":     validateAwsAccount,
+	"upload_aws_s3_action":                 validateUploadAwsS3Action,
+	"run_aws_cli_script_action":            validateRunAwsCliScriptAction,
+	"deploy_azure_resource_group_action":   validateDeployAzureResourceGroupAction,
+	"run_azure_script_action":              validateScriptSource,
+	"apply_terraform_action":               validateTerraformAction,
+	"plan_terraform_action":                validateTerraformAction,
+	"destroy_terraform_action":             validateTerraformAction,
+	"plan_destroy_terraform_action":        validateTerraformAction,
+	"deploy_tomcat_action":                 validateJavaCredentials,
+	"tomcat_state_action":                  validateJavaCredentials,
+	"deploy_wildfly_action":                validateJavaCredentials,
+	"wildfly_state_action":                 validateJavaCredentials,
+	"configure_wildfly_certificate_action": validateConfigureWildFlyCertificateAction,
+	"deploy_windows_service_action":        validateWindowsService,
+	"deploy_package_action":                validateDeployPackageAction,
+}
+
+// actionReferenceValidateFuncs are the action blocks that check the items they reference exist at plan time
+var actionReferenceValidateFuncs = map[string]func(client *octopusdeploy.Client, tfAction map[string]interface{}) error{
+	"deploy_azure_web_app_action":        validateAzureAccount,
+	"deploy_azure_resource_group_action": validateAzureAccount,
+	"run_azure_script_action":            validateAzureAccount,
+}
+
+func validateDeploymentStep(tfStep map[string]interface{}) error {
+	if err := validateDeploymentStepActions(tfStep); err != nil {
+		return err
+	}
+
+	return forEachDeploymentStepAction(tfStep, func(block string, tfAction map[string]interface{}) error {
+		if validateFunc, ok := actionValidateFuncs[block]; ok {
+			return validateFunc(tfAction)
+		}
+		return nil
+	})
+}
+
+func validateDeploymentStepReferences(client *octopusdeploy.Client, tfStep map[string]interface{}) error {
+	return forEachDeploymentStepAction(tfStep, func(block string, tfAction map[string]interface{}) error {
+		if validateFunc, ok := actionReferenceValidateFuncs[block]; ok {
+			return validateFunc(client, tfAction)
+		}
+		return nil
+	})
+}
+
+// actionFlattenFuncs are the action blocks that refresh their attributes from the properties Octopus returns
+var actionFlattenFuncs = map[string]func(tfAction map[string]interface{}, properties map[string]string){
+	"run_script_action":                 flattenScriptSourceProperties,
+	"run_kubectl_script_action":         flattenScriptSourceProperties,
+	"run_aws_cli_script_action":         flattenScriptSourceProperties,
+	"run_azure_script_action":           flattenScriptSourceProperties,
+	"deploy_azure_web_app_action":       flattenPackageFeatures,
+	"deploy_java_archive_action":        flattenPackageFeatures,
+	"deploy_tomcat_action":              flattenPackageFeatures,
+	"deploy_wildfly_action":             flattenPackageFeatures,
+	"deploy_package_action":             flattenDeployPackageAction,
+	"deploy_windows_service_action":     flattenDeployWindowsServiceAction,
+	"deploy_raw_kubernetes_yaml_action": flattenPackageFeatures,
+}
+
+// flattenDeploymentStep refreshes a step in state from the matching step Octopus returned. Actions are
+// matched by name, as the server returns all the actions of a step in a single list. Configured actions the
+// server doesn't have are dropped, and actions it has that aren't configured are added as child actions, so
+// actions added or removed outside Terraform show as changes.
+func flattenDeploymentStep(tfStep map[string]interface{}, step octopusdeploy.DeploymentStep) {
+	tfStep["package_requirement"] = string(step.PackageRequirement)
+	tfStep["condition"] = string(step.Condition)
+	tfStep["condition_expression"] = step.Properties["Octopus.Action.ConditionVariableExpression"]
+	tfStep["start_trigger"] = string(step.StartTrigger)
+	tfStep["window_size"] = step.Properties["Octopus.Action.MaxParallelism"]
+
+	tfStep["target_roles"] = []interface{}{}
+	if targetRoles := step.Properties["Octopus.Action.TargetRoles"]; targetRoles != "" {
+		for _, targetRole := range strings.Split(targetRoles, ",") {
+			tfStep["target_roles"] = append(tfStep["target_roles"].([]interface{}), targetRole)
+		}
+	}
+
+	actions := map[string]octopusdeploy.DeploymentAction{}
+	for _, action := range step.Actions {
+		actions[action.Name] = action
+	}
+
+	flattened := map[string]bool{}
+	for _, block := range deploymentActionBlocks {
+		if attr, ok := tfStep[block.name]; ok && attr != nil {
+			tfActions := []interface{}{}
+			for _, tfAction := range attr.([]interface{}) {
+				name := tfAction.(map[string]interface{})["name"].(string)
+				if _, ok := actions[name]; ok {
+					flattenDeploymentStepAction(block.name, tfAction.(map[string]interface{}), actions)
+					tfActions = append(tfActions, tfAction)
+					flattened[name] = true
+				}
+			}
+			tfStep[block.name] = tfActions
+		}
+	}
+
+	tfChildActions := map[string]map[string]interface{}{}
+	for _, tfChildAction := range getChildActions(tfStep) {
+		if _, tfAction := getChildActionBlock(tfChildAction); tfAction != nil {
+			tfChildActions[tfAction["name"].(string)] = tfChildAction
+		}
+	}
+
+	/* The other actions are read back as child actions in the order Octopus runs them, so a reordered step
+	shows as a change */
+	childActions := []interface{}{}
+	for _, action := range step.Actions {
+		if flattened[action.Name] {
+			continue
+		}
+
+		tfChildAction, ok := tfChildActions[action.Name]
+		if !ok {
+			tfChildAction = flattenUnconfiguredDeploymentAction(action)
+		} else if block, tfAction := getChildActionBlock(tfChildAction); tfAction != nil {
+			flattenDeploymentStepAction(block.name, tfAction, actions)
+		}
+		childActions = append(childActions, tfChildAction)
+	}
+
+	tfStep["child_action"] = childActions
+}
+
+// flattenUnconfiguredDeploymentAction returns a child action holding an action block for an action Octopus has
+// that isn't configured. Only the attributes common to every action type are read back.
+func flattenUnconfiguredDeploymentAction(action octopusdeploy.DeploymentAction) map[string]interface{} {
+	return map[string]interface{}{
+		"action": []interface{}{
+			map[string]interface{}{
+				"name":                  action.Name,
+				"action_type":           action.ActionType,
+				"disabled":              action.IsDisabled,
+				"required":              action.IsRequired,
+				"environments":          action.Environments,
+				"excluded_environments": action.ExcludedEnvironments,
+				"channels":              action.Channels,
+				"tenant_tags":           action.TenantTags,
+				"worker_pool_id":        action.WorkerPoolId,
+			},
+		},
+	}
+}
+
+// flattenDeploymentStepAction refreshes an action block from the action of the same name, for the action
+// blocks that read their attributes back
+func flattenDeploymentStepAction(block string, tfAction map[string]interface{}, actions map[string]octopusdeploy.DeploymentAction) {
+	flattenFunc, ok := actionFlattenFuncs[block]
+	if !ok {
+		return
+	}
+
+	if action, ok := actions[tfAction["name"].(string)]; ok {
+		flattenFunc(tfAction, action.Properties)
+	}
 }
@@ -0,0 +1,156 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// addJavaCredentialsSchema adds the username and password of the Tomcat manager or WildFly management interface
+func addJavaCredentialsSchema(element *schema.Resource) {
+	element.Schema["username"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The user to authenticate as",
+		Optional:    true,
+	}
+
+	element.Schema["password"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The password of the user",
+		Optional:    true,
+		Sensitive:   true,
+	}
+}
+
+// validateJavaCredentials checks that the username and password of the action are supplied together
+func validateJavaCredentials(tfAction map[string]interface{}) error {
+	if (tfAction["username"].(string) != "") != (tfAction["password"].(string) != "") {
+		return fmt.Errorf("action %s: username and password must be set together", tfAction["name"].(string))
+	}
+
+	return nil
+}
+
+// addTomcatManagerSchema adds the connection to the Tomcat manager shared by the Tomcat actions
+func addTomcatManagerSchema(element *schema.Resource) {
+	element.Schema["manager_url"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The URL of the Tomcat manager, e.g. http://localhost:8080/manager",
+		Required:    true,
+	}
+
+	addJavaCredentialsSchema(element)
+}
+
+func buildTomcatManagerProperties(tfAction map[string]interface{}) map[string]string {
+	return map[string]string{
+		"Tomcat.Deploy.Controller": tfAction["manager_url"].(string),
+		"Tomcat.Deploy.User":       tfAction["username"].(string),
+		"Tomcat.Deploy.Password":   tfAction["password"].(string),
+	}
+}
+
+// addWildFlyManagementSchema adds the connection to the WildFly management interface shared by the WildFly actions
+func addWildFlyManagementSchema(element *schema.Resource) {
+	element.Schema["host"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The host of the management interface",
+		Optional:    true,
+		Default:     "localhost",
+	}
+
+	element.Schema["port"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "The port of the management interface",
+		Optional:    true,
+		Default:     9990,
+	}
+
+	element.Schema["protocol"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "The protocol of the management interface",
+		Optional:     true,
+		Default:      "remote+http",
+		ValidateFunc: validateValueFunc([]string{"remote+http", "remote+https", "http-remoting", "https-remoting", "remote"}),
+	}
+
+	element.Schema["server_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Whether WildFly runs as a 'Standalone' server or a 'Domain'",
+		Optional:     true,
+		Default:      "Standalone",
+		ValidateFunc: validateValueFunc([]string{"Standalone", "Domain"}),
+	}
+
+	addJavaCredentialsSchema(element)
+}
+
+func buildWildFlyManagementProperties(tfAction map[string]interface{}) map[string]string {
+	return map[string]string{
+		"WildFly.Deploy.Controller": tfAction["host"].(string),
+		"WildFly.Deploy.Port":       strconv.Itoa(tfAction["port"].(int)),
+		"WildFly.Deploy.Protocol":   tfAction["protocol"].(string),
+		"WildFly.Deploy.ServerType": tfAction["server_type"].(string),
+		"WildFly.Deploy.User":       tfAction["username"].(string),
+		"WildFly.Deploy.Password":   tfAction["password"].(string),
+	}
+}
+
+// addWildFlyServerGroupsSchema adds the server groups a deployment is enabled or disabled in, for domain servers
+func addWildFlyServerGroupsSchema(element *schema.Resource) {
+	element.Schema["enabled_server_groups"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The server groups the deployment is enabled in, for domain servers",
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	element.Schema["disabled_server_groups"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The server groups the deployment is disabled in, for domain servers",
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// addJavaKeystoreSchema adds the keystore the certificate actions write the certificate to
+func addJavaKeystoreSchema(element *schema.Resource) {
+	element.Schema["certificate_variable"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the project variable holding the certificate",
+		Required:    true,
+	}
+
+	element.Schema["keystore_filename"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The path of the keystore to create. Defaults to a keystore in the server configuration",
+		Optional:    true,
+	}
+
+	element.Schema["keystore_password"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The password of the keystore",
+		Optional:    true,
+		Sensitive:   true,
+	}
+
+	element.Schema["keystore_alias"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The alias of the certificate in the keystore",
+		Optional:    true,
+	}
+}
+
+func buildJavaKeystoreProperties(tfAction map[string]interface{}) map[string]string {
+	return map[string]string{
+		"Java.Certificate.Variable":         tfAction["certificate_variable"].(string),
+		"Java.Certificate.KeystoreFilename": tfAction["keystore_filename"].(string),
+		"Java.Certificate.Password":         tfAction["keystore_password"].(string),
+		"Java.Certificate.KeystoreAlias":    tfAction["keystore_alias"].(string),
+	}
+}
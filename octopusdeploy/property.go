@@ -1,6 +1,10 @@
 package octopusdeploy
 
-import "github.com/hashicorp/terraform/helper/schema"
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
 
 func getPropertySchema() *schema.Schema {
 	return &schema.Schema{
@@ -33,3 +37,9 @@ func buildPropertiesMap(tfProperties interface{}) map[string]string {
 	}
 	return properties
 }
+
+// parseBoolProperty reads a boolean action property, treating missing or invalid values as false
+func parseBoolProperty(value string) bool {
+	result, err := strconv.ParseBool(value)
+	return err == nil && result
+}
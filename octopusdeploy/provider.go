@@ -20,27 +20,55 @@ func Provider() terraform.ResourceProvider {
 			"octopusdeploy_lifecycle":            dataLifecycle(),
 			"octopusdeploy_feed":                 dataFeed(),
 			"octopusdeploy_account":              dataAccount(),
+			"octopusdeploy_environments":         dataEnvironments(),
+			"octopusdeploy_projects":             dataProjects(),
+			"octopusdeploy_machines":             dataMachines(),
+			"octopusdeploy_accounts":             dataAccounts(),
+			"octopusdeploy_feeds":                dataFeeds(),
+			"octopusdeploy_lifecycles":           dataLifecycles(),
+			"octopusdeploy_certificates":         dataCertificates(),
+			"octopusdeploy_channel":              dataChannel(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"octopusdeploy_project":                           resourceProject(),
-			"octopusdeploy_project_group":                     resourceProjectGroup(),
-			"octopusdeploy_project_deployment_target_trigger": resourceProjectDeploymentTargetTrigger(),
-			"octopusdeploy_deployment_step_deploy_package":    resourceDeploymentStepDeployPackage(),
-			"octopusdeploy_deployment_step_inline_script":     resourceDeploymentStepInlineScript(),
-			"octopusdeploy_deployment_step_iis_website":       resourceDeploymentStepIisWebsite(),
-			"octopusdeploy_deployment_step_iis_webapp":        resourceDeploymentStepIisWebapp(),
-			"octopusdeploy_environment":                       resourceEnvironment(),
-			"octopusdeploy_account":                           resourceAccount(),
-			"octopusdeploy_feed":                              resourceFeed(),
-			"octopusdeploy_variable":                          resourceVariable(),
-			"octopusdeploy_machine":                           resourceMachine(),
-			"octopusdeploy_library_variable_set":              resourceLibraryVariableSet(),
-			"octopusdeploy_lifecycle":                         resourceLifecycle(),
-			"octopusdeploy_deployment_process":                resourceDeploymentProcess(),
-			"octopusdeploy_tag_set":                           resourceTagSet(),
-			"octopusdeploy_certificate":                       resourceCertificate(),
-			"octopusdeploy_channel":                           resourceChannel(),
-			"octopusdeploy_nuget_feed":                        resourceNugetFeed(),
+			"octopusdeploy_project":                                      resourceProject(),
+			"octopusdeploy_project_group":                                resourceProjectGroup(),
+			"octopusdeploy_project_deployment_target_trigger":            resourceProjectDeploymentTargetTrigger(),
+			"octopusdeploy_deployment_step_deploy_package":               resourceDeploymentStepDeployPackage(),
+			"octopusdeploy_deployment_step_inline_script":                resourceDeploymentStepInlineScript(),
+			"octopusdeploy_deployment_step_iis_website":                  resourceDeploymentStepIisWebsite(),
+			"octopusdeploy_deployment_step_iis_webapp":                   resourceDeploymentStepIisWebapp(),
+			"octopusdeploy_deployment_step_iis_virtual_directory":        resourceDeploymentStepIisVirtualDirectory(),
+			"octopusdeploy_deployment_step_run_script":                   resourceDeploymentStepRunScript(),
+			"octopusdeploy_deployment_step_run_kubectl_script":           resourceDeploymentStepRunKubectlScript(),
+			"octopusdeploy_deployment_step_deploy_kubernetes_containers": resourceDeploymentStepDeployKubernetesContainers(),
+			"octopusdeploy_deployment_step_deploy_kubernetes_secret":     resourceDeploymentStepDeployKubernetesSecret(),
+			"octopusdeploy_deployment_step_deploy_kubernetes_config_map": resourceDeploymentStepDeployKubernetesConfigMap(),
+			"octopusdeploy_deployment_step_deploy_raw_kubernetes_yaml":   resourceDeploymentStepDeployRawKubernetesYaml(),
+			"octopusdeploy_deployment_step_upgrade_helm_chart":           resourceDeploymentStepUpgradeHelmChart(),
+			"octopusdeploy_deployment_step_apply_terraform":              resourceDeploymentStepApplyTerraform(),
+			"octopusdeploy_deployment_step_plan_terraform":               resourceDeploymentStepPlanTerraform(),
+			"octopusdeploy_deployment_step_destroy_terraform":            resourceDeploymentStepDestroyTerraform(),
+			"octopusdeploy_deployment_step_plan_destroy_terraform":       resourceDeploymentStepPlanDestroyTerraform(),
+			"octopusdeploy_deployment_step_manual_intervention":          resourceDeploymentStepManualIntervention(),
+			"octopusdeploy_deployment_step_deploy_windows_service":       resourceDeploymentStepDeployWindowsService(),
+			"octopusdeploy_environment":                                  resourceEnvironment(),
+			"octopusdeploy_account":                                      resourceAccount(),
+			"octopusdeploy_feed":                                         resourceFeed(),
+			"octopusdeploy_variable":                                     resourceVariable(),
+			"octopusdeploy_project_variables":                            resourceProjectVariables(),
+			"octopusdeploy_library_variable_set_variables":               resourceLibraryVariableSetVariables(),
+			"octopusdeploy_machine":                                      resourceMachine(),
+			"octopusdeploy_library_variable_set":                         resourceLibraryVariableSet(),
+			"octopusdeploy_lifecycle":                                    resourceLifecycle(),
+			"octopusdeploy_deployment_process":                           resourceDeploymentProcess(),
+			"octopusdeploy_runbook":                                      resourceRunbook(),
+			"octopusdeploy_runbook_process":                              resourceRunbookProcess(),
+			"octopusdeploy_runbook_scheduled_trigger":                    resourceRunbookScheduledTrigger(),
+			"octopusdeploy_tag_set":                                      resourceTagSet(),
+			"octopusdeploy_certificate":                                  resourceCertificate(),
+			"octopusdeploy_generated_certificate":                        resourceGeneratedCertificate(),
+			"octopusdeploy_channel":                                      resourceChannel(),
+			"octopusdeploy_nuget_feed":                                   resourceNugetFeed(),
 		},
 		Schema: map[string]*schema.Schema{
 			"address": {
@@ -61,6 +89,18 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_SPACE", ""),
 				Description: "The name of the Space in Octopus Deploy server",
 			},
+			"certificate_expiry_warning_days": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Log a warning when planning a Certificate variable whose certificate expires within this many days. 0 disables the warning",
+			},
+			"certificate_expiry_fail_plan": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail the plan instead of logging a warning when a Certificate variable's certificate expires within certificate_expiry_warning_days",
+			},
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -72,10 +112,16 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Address: d.Get("address").(string),
 		APIKey:  d.Get("apikey").(string),
 		Space:   d.Get("space").(string),
+
+		CertificateExpiryWarningDays: d.Get("certificate_expiry_warning_days").(int),
+		CertificateExpiryFailPlan:    d.Get("certificate_expiry_fail_plan").(bool),
 	}
 
 	log.Println("[INFO] Initializing Octopus Deploy client")
-	client, err := config.Client()
+	client, api, err := config.Client()
+	if err != nil {
+		return nil, err
+	}
 
-	return client, err
+	return &providerMeta{Client: client, API: api, Config: config}, nil
 }
@@ -71,7 +71,7 @@ func resourceAccount() *schema.Resource {
 }
 
 func resourceAccountRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	accountId := d.Id()
 	account, err := client.Account.Get(accountId)
@@ -179,7 +179,7 @@ func buildAccountResource(d *schema.ResourceData) *octopusdeploy.Account {
 }
 
 func resourceAccountCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	newAccount := buildAccountResource(d)
 	account, err := client.Account.Add(newAccount)
@@ -197,7 +197,7 @@ func resourceAccountUpdate(d *schema.ResourceData, m interface{}) error {
 	account := buildAccountResource(d)
 	account.ID = d.Id() // set project struct ID so octopus knows which project to update
 
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	updatedAccount, err := client.Account.Update(account)
 
@@ -210,7 +210,7 @@ func resourceAccountUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceAccountDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	accountId := d.Id()
 
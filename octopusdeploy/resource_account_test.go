@@ -83,7 +83,7 @@ func testAccountBasic(tagSetName string, tagName string, accountName string, acc
 
 func testOctopusDeployAccountExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 		return existsaccountHelper(s, client)
 	}
 }
@@ -100,7 +100,7 @@ func existsaccountHelper(s *terraform.State, client *octopusdeploy.Client) error
 }
 
 func testOctopusDeployAccountDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	client := testAccProvider.Meta().(*providerMeta).Client
 	return destroyaccountHelper(s, client)
 }
 
@@ -2,6 +2,7 @@ package octopusdeploy
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -55,12 +56,79 @@ func resourceCertificate() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"archived": {
+				Type:        schema.TypeBool,
+				Description: "Whether the certificate is archived. Archived certificates can't be used by new deployments",
+				Optional:    true,
+				Default:     false,
+			},
+			"archive_on_destroy": {
+				Type:        schema.TypeBool,
+				Description: "Whether destroying the resource archives the certificate instead of deleting it",
+				Optional:    true,
+				Default:     false,
+			},
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject_distinguished_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject_common_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issuer_distinguished_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issuer_common_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"self_signed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"serial_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_before": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_expired": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_private_key": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"certificate_data_format": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject_alternative_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
 
 func resourceCertificateRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	certificateId := d.Id()
 	certificate, err := client.Certificate.Get(certificateId)
@@ -77,28 +145,32 @@ func resourceCertificateRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("name", certificate.Name)
 	d.Set("notes", certificate.Notes)
 	d.Set("environment_ids", certificate.EnvironmentIds)
-	d.Set("tenanted_deployment_participation", certificate.TenantedDeploymentParticipation)
+	d.Set("tenanted_deployment_participation", certificate.TenantedDeploymentParticipation.String())
 	d.Set("tenant_ids", certificate.TenantIds)
 	d.Set("tenant_tags", certificate.TenantTags)
+	d.Set("archived", certificate.Archived != "")
+	d.Set("thumbprint", certificate.Thumbprint)
+	d.Set("subject_distinguished_name", certificate.SubjectDistinguishedName)
+	d.Set("subject_common_name", certificate.SubjectCommonName)
+	d.Set("issuer_distinguished_name", certificate.IssuerDistinguishedName)
+	d.Set("issuer_common_name", certificate.IssuerCommonName)
+	d.Set("self_signed", certificate.SelfSigned)
+	d.Set("serial_number", certificate.SerialNumber)
+	d.Set("not_before", certificate.NotBefore)
+	d.Set("not_after", certificate.NotAfter)
+	d.Set("is_expired", certificate.IsExpired)
+	d.Set("has_private_key", certificate.HasPrivateKey)
+	d.Set("certificate_data_format", certificate.CertificateDataFormat)
+	d.Set("subject_alternative_names", certificate.SubjectAlternativeNames)
 
 	return nil
 }
 
-func buildCertificateResource(d *schema.ResourceData) *octopusdeploy.Certificate {
+func buildCertificateResource(d *schema.ResourceData) (*octopusdeploy.Certificate, error) {
 	certificateName := d.Get("name").(string)
 
-	var notes string
 	var certificateData string
 	var password string
-	var environmentIds []string
-	var tenantedDeploymentParticipation int
-	var tenantIds []string
-	var tenantTags []string
-
-	notesInterface, ok := d.GetOk("notes")
-	if ok {
-		notes = notesInterface.(string)
-	}
 
 	certificateDataInterface, ok := d.GetOk("certificate_data")
 	if ok {
@@ -110,6 +182,27 @@ func buildCertificateResource(d *schema.ResourceData) *octopusdeploy.Certificate
 		password = passwordInterface.(string)
 	}
 
+	var certificate = octopusdeploy.NewCertificate(certificateName, octopusdeploy.SensitiveValue{NewValue: certificateData}, octopusdeploy.SensitiveValue{NewValue: password})
+	if err := buildCertificateMetadata(d, certificate); err != nil {
+		return nil, err
+	}
+
+	return certificate, nil
+}
+
+// buildCertificateMetadata sets the attributes of the certificate that can be changed without replacing it
+func buildCertificateMetadata(d *schema.ResourceData, certificate *octopusdeploy.Certificate) error {
+	var notes string
+	var environmentIds []string
+	var tenantedDeploymentParticipation octopusdeploy.TenantedDeploymentMode
+	var tenantIds []string
+	var tenantTags []string
+
+	notesInterface, ok := d.GetOk("notes")
+	if ok {
+		notes = notesInterface.(string)
+	}
+
 	environmentIdsInterface, ok := d.GetOk("environment_ids")
 	if ok {
 		environmentIds = getSliceFromTerraformTypeList(environmentIdsInterface)
@@ -121,7 +214,11 @@ func buildCertificateResource(d *schema.ResourceData) *octopusdeploy.Certificate
 
 	tenantedDeploymentParticipationInterface, ok := d.GetOk("tenanted_deployment_participation")
 	if ok {
-		tenantedDeploymentParticipation = tenantedDeploymentParticipationInterface.(int)
+		var err error
+		tenantedDeploymentParticipation, err = octopusdeploy.ParseTenantedDeploymentMode(tenantedDeploymentParticipationInterface.(string))
+		if err != nil {
+			return fmt.Errorf("error parsing tenanted_deployment_participation of certificate %s: %s", d.Get("name"), err.Error())
+		}
 	}
 
 	tenantIdsInterface, ok := d.GetOk("tenant_ids")
@@ -142,20 +239,24 @@ func buildCertificateResource(d *schema.ResourceData) *octopusdeploy.Certificate
 		tenantTags = []string{}
 	}
 
-	var certificate = octopusdeploy.NewCertificate(certificateName, octopusdeploy.SensitiveValue{NewValue: certificateData}, octopusdeploy.SensitiveValue{NewValue: password})
+	certificate.Name = d.Get("name").(string)
 	certificate.Notes = notes
 	certificate.EnvironmentIds = environmentIds
-	certificate.TenantedDeploymentParticipation = octopusdeploy.TenantedDeploymentMode(tenantedDeploymentParticipation)
+	certificate.TenantedDeploymentParticipation = tenantedDeploymentParticipation
 	certificate.TenantIds = tenantIds
 	certificate.TenantTags = tenantTags
 
-	return certificate
+	return nil
 }
 
 func resourceCertificateCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
+
+	newCertificate, err := buildCertificateResource(d)
+	if err != nil {
+		return err
+	}
 
-	newCertificate := buildCertificateResource(d)
 	certificate, err := client.Certificate.Add(newCertificate)
 
 	if err != nil {
@@ -164,44 +265,105 @@ func resourceCertificateCreate(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(certificate.ID)
 
-	return nil
+	if err := updateCertificateArchived(d, m.(*providerMeta).API, true); err != nil {
+		return err
+	}
+
+	return resourceCertificateRead(d, m)
 }
 
+// resourceCertificateUpdate replaces the certificate when its data or password changed, and saves the other
+// attributes with a normal update. Replacing keeps the ID, and archives the previous certificate on the server.
 func resourceCertificateUpdate(d *schema.ResourceData, m interface{}) error {
-	certificate := buildCertificateResource(d)
-	certificate.ID = d.Id()
+	client := m.(*providerMeta).Client
+	api := m.(*providerMeta).API
 
-	client := m.(*octopusdeploy.Client)
+	if err := updateCertificateArchived(d, api, false); err != nil {
+		return err
+	}
 
-	var certificateData string
-	var password string
+	if d.HasChange("certificate_data") || d.HasChange("password") {
+		certificateReplace := octopusdeploy.NewCertificateReplace(d.Get("certificate_data").(string), d.Get("password").(string))
 
-	certificateDataInterface, ok := d.GetOk("certificate_data")
-	if ok {
-		certificateData = certificateDataInterface.(string)
+		if _, err := client.Certificate.Replace(d.Id(), certificateReplace); err != nil {
+			return fmt.Errorf("error replacing certificate id %s: %s", d.Id(), err.Error())
+		}
 	}
 
-	passwordInterface, ok := d.GetOk("password")
-	if ok {
-		password = passwordInterface.(string)
+	if err := updateCertificateMetadata(d, client); err != nil {
+		return err
 	}
 
-	certificateReplace := octopusdeploy.NewCertificateReplace(certificateData, password)
-	updatedCertificate, err := client.Certificate.Replace(certificate.ID, certificateReplace)
+	if err := updateCertificateArchived(d, api, true); err != nil {
+		return err
+	}
+
+	return resourceCertificateRead(d, m)
+}
+
+// updateCertificateMetadata saves the attributes set by buildCertificateMetadata when any of them changed
+func updateCertificateMetadata(d *schema.ResourceData, client *octopusdeploy.Client) error {
+	if !d.HasChange("name") && !d.HasChange("notes") && !d.HasChange("environment_ids") && !d.HasChange("tenanted_deployment_participation") && !d.HasChange("tenant_ids") && !d.HasChange("tenant_tags") {
+		return nil
+	}
 
+	certificate, err := client.Certificate.Get(d.Id())
 	if err != nil {
+		return fmt.Errorf("error reading certificate id %s: %s", d.Id(), err.Error())
+	}
+
+	if err := buildCertificateMetadata(d, certificate); err != nil {
+		return err
+	}
+
+	// The data and password can only be changed by replacing the certificate, so keep the stored values
+	certificate.CertificateData = octopusdeploy.SensitiveValue{HasValue: true}
+	certificate.Password = octopusdeploy.SensitiveValue{HasValue: certificate.Password.HasValue}
+
+	if _, err := client.Certificate.Update(certificate); err != nil {
 		return fmt.Errorf("error updating certificate id %s: %s", d.Id(), err.Error())
 	}
 
-	d.SetId(updatedCertificate.ID)
 	return nil
 }
 
+// updateCertificateArchived archives or unarchives the certificate when archived changed to the given value.
+// Updates unarchive a certificate before saving its other changes, and archive it after.
+func updateCertificateArchived(d *schema.ResourceData, api *apiClient, archived bool) error {
+	if !d.HasChange("archived") || d.Get("archived").(bool) != archived {
+		return nil
+	}
+
+	if archived {
+		if _, err := api.Certificate.Archive(d.Id()); err != nil {
+			return fmt.Errorf("error archiving certificate id %s: %s", d.Id(), err.Error())
+		}
+	} else {
+		if _, err := api.Certificate.Unarchive(d.Id()); err != nil {
+			return fmt.Errorf("error unarchiving certificate id %s: %s", d.Id(), err.Error())
+		}
+	}
+
+	return nil
+}
+
+// resourceCertificateDelete deletes the certificate, or archives it when archive_on_destroy is set
 func resourceCertificateDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	certificateId := d.Id()
 
+	if d.Get("archive_on_destroy").(bool) {
+		if !d.Get("archived").(bool) {
+			if _, err := m.(*providerMeta).API.Certificate.Archive(certificateId); err != nil {
+				return fmt.Errorf("error archiving certificate id %s: %s", certificateId, err.Error())
+			}
+		}
+
+		d.SetId("")
+		return nil
+	}
+
 	err := client.Certificate.Delete(certificateId)
 
 	if err != nil {
@@ -211,3 +373,17 @@ func resourceCertificateDelete(d *schema.ResourceData, m interface{}) error {
 	d.SetId("")
 	return nil
 }
+
+// certificateExpiresWithin returns true if the certificate has expired, or expires within the given number of days
+func certificateExpiresWithin(certificate *octopusdeploy.Certificate, days int, now time.Time) (bool, error) {
+	if certificate.IsExpired {
+		return true, nil
+	}
+
+	notAfter, err := time.Parse(time.RFC3339, certificate.NotAfter)
+	if err != nil {
+		return false, fmt.Errorf("error parsing expiry date %s of certificate %s: %s", certificate.NotAfter, certificate.ID, err.Error())
+	}
+
+	return notAfter.Before(now.AddDate(0, 0, days)), nil
+}
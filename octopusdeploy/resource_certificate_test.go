@@ -3,6 +3,7 @@ package octopusdeploy
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -39,12 +40,100 @@ func TestAccOctopusDeployCertificateBasic(t *testing.T) {
 						certPrefix, "tenant_tags.0", tenantTags),
 					resource.TestCheckResourceAttr(
 						certPrefix, "tenanted_deployment_participation", tenantedDeploymentParticipation),
+					resource.TestCheckResourceAttr(
+						certPrefix, "subject_common_name", "demo.octopus.com"),
+					resource.TestCheckResourceAttr(
+						certPrefix, "has_private_key", "false"),
+					resource.TestCheckResourceAttrSet(
+						certPrefix, "thumbprint"),
+					resource.TestCheckResourceAttrSet(
+						certPrefix, "not_after"),
+				),
+			},
+			{
+				// Changing only the notes updates the certificate in place instead of replacing the data
+				Config: testCertificateBasic(tagSetName, tagName, envName, certName, "Updated notes", certData, tenantedDeploymentParticipation),
+				Check: resource.ComposeTestCheckFunc(
+					testOctopusDeployCertificateExists(certPrefix),
+					resource.TestCheckResourceAttr(
+						certPrefix, "notes", "Updated notes"),
+					resource.TestCheckResourceAttr(
+						certPrefix, "subject_common_name", "demo.octopus.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployCertificateArchive(t *testing.T) {
+	const certPrefix = "octopusdeploy_generated_certificate.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testOctopusDeployCertificateArchivedOnDestroy(certPrefix),
+		Steps: []resource.TestStep{
+			{
+				Config: testCertificateArchive(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(certPrefix, "archived", "true"),
+					testOctopusDeployCertificateArchived(certPrefix, true),
+				),
+			},
+			{
+				Config: testCertificateArchive(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(certPrefix, "archived", "false"),
+					testOctopusDeployCertificateArchived(certPrefix, false),
 				),
 			},
 		},
 	})
 }
 
+func testCertificateArchive(archived bool) string {
+	return fmt.Sprintf(`
+		resource "octopusdeploy_generated_certificate" "foo" {
+			name               = "Archive Test"
+			common_name        = "archive.example.com"
+			archived           = %t
+			archive_on_destroy = true
+		}
+		`,
+		archived,
+	)
+}
+
+func testOctopusDeployCertificateArchived(n string, archived bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		certificate, err := client.Certificate.Get(s.RootModule().Resources[n].Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Received an error retrieving certificate %s", err)
+		}
+
+		if (certificate.Archived != "") != archived {
+			return fmt.Errorf("Certificate archived is %q, expected archived to be %t", certificate.Archived, archived)
+		}
+
+		return nil
+	}
+}
+
+// testOctopusDeployCertificateArchivedOnDestroy checks the destroyed certificate was archived rather than deleted,
+// then deletes it
+func testOctopusDeployCertificateArchivedOnDestroy(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if err := testOctopusDeployCertificateArchived(n, true)(s); err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).Client
+		return client.Certificate.Delete(s.RootModule().Resources[n].Primary.ID)
+	}
+}
+
 func testCertificateBasic(tagSetName string, tagName string, environmentName string, certName string, notes string, certificateData string, tenantedDeploymentParticipation string) string {
 	return fmt.Sprintf(`
 		
@@ -76,7 +165,7 @@ func testCertificateBasic(tagSetName string, tagName string, environmentName str
 
 func testOctopusDeployCertificateExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 		return existscertHelper(s, client)
 	}
 }
@@ -93,7 +182,7 @@ func existscertHelper(s *terraform.State, client *octopusdeploy.Client) error {
 }
 
 func testOctopusDeployCertificateDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	client := testAccProvider.Meta().(*providerMeta).Client
 	return destroycertHelper(s, client)
 }
 
@@ -109,3 +198,35 @@ func destroycertHelper(s *terraform.State, client *octopusdeploy.Client) error {
 	}
 	return fmt.Errorf("Certificate still exists")
 }
+
+func TestCertificateExpiresWithin(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name        string
+		certificate octopusdeploy.Certificate
+		days        int
+		expires     bool
+		err         bool
+	}{
+		{"expired flag", octopusdeploy.Certificate{IsExpired: true, NotAfter: "2030-01-01T00:00:00Z"}, 30, true, false},
+		{"already expired", octopusdeploy.Certificate{NotAfter: "2019-12-31T00:00:00Z"}, 0, true, false},
+		{"valid today", octopusdeploy.Certificate{NotAfter: "2020-01-02T00:00:00Z"}, 0, false, false},
+		{"within window", octopusdeploy.Certificate{NotAfter: "2020-01-20T00:00:00Z"}, 30, true, false},
+		{"after window", octopusdeploy.Certificate{NotAfter: "2020-03-01T00:00:00Z"}, 30, false, false},
+		{"with offset", octopusdeploy.Certificate{NotAfter: "2020-01-31T10:00:00+11:00"}, 30, true, false},
+		{"invalid date", octopusdeploy.Certificate{NotAfter: "31/01/2020"}, 30, false, true},
+	}
+
+	for _, c := range cases {
+		expires, err := certificateExpiresWithin(&c.certificate, c.days, now)
+		if (err != nil) != c.err {
+			t.Errorf("%s: unexpected error %v", c.name, err)
+			continue
+		}
+
+		if expires != c.expires {
+			t.Errorf("%s: expected %t, got %t", c.name, c.expires, expires)
+		}
+	}
+}
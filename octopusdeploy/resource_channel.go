@@ -2,6 +2,10 @@ package octopusdeploy
 
 import (
 	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
 
 	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -35,23 +39,53 @@ func resourceChannel() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"tenant_tags": {
+				Type:        schema.TypeList,
+				Description: "The tenant tags of the tenants that can deploy releases of the channel",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"rule": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"version_range": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Description:  "The NuGet or Maven version range of the packages, e.g. [1.0,2.0)",
+							Optional:     true,
+							ValidateFunc: validateVersionRange,
 						},
 						"tag": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Description:  "A regular expression the pre-release tag of the packages must match",
+							Optional:     true,
+							ValidateFunc: validateTagRegex,
 						},
 						"actions": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Type:        schema.TypeList,
+							Description: "The names of the deployment actions whose primary packages the rule applies to",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"action_package": {
+							Type:        schema.TypeList,
+							Description: "A package reference of a deployment action the rule applies to",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"deployment_action": {
+										Type:        schema.TypeString,
+										Description: "The name of the deployment action",
+										Required:    true,
+									},
+									"package_reference": {
+										Type:        schema.TypeString,
+										Description: "The name of the package reference of the action",
+										Required:    true,
+									},
+								},
+							},
 						},
 					},
 				},
@@ -61,10 +95,10 @@ func resourceChannel() *schema.Resource {
 }
 
 func resourceChannelCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	api := m.(*providerMeta).API
 
 	newChannel := buildChannelResource(d)
-	channel, err := client.Channel.Add(newChannel)
+	channel, err := api.Channel.Add(newChannel)
 
 	if err != nil {
 		return fmt.Errorf("error creating channel %s: %s", newChannel.Name, err.Error())
@@ -75,13 +109,15 @@ func resourceChannelCreate(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func buildChannelResource(d *schema.ResourceData) *octopusdeploy.Channel {
-	channel := &octopusdeploy.Channel{
+func buildChannelResource(d *schema.ResourceData) *channel {
+	channel := &channel{
 		Name:        d.Get("name").(string),
 		Description: d.Get("description").(string),
 		ProjectID:   d.Get("project_id").(string),
 		LifecycleID: d.Get("lifecycle_id").(string),
 		IsDefault:   d.Get("is_default").(bool),
+		TenantTags:  getSliceFromTerraformTypeList(d.Get("tenant_tags")),
+		Rules:       []channelRule{},
 	}
 
 	if attr, ok := d.GetOk("rule"); ok {
@@ -96,23 +132,200 @@ func buildChannelResource(d *schema.ResourceData) *octopusdeploy.Channel {
 	return channel
 }
 
-func buildRulesResource(tfRule map[string]interface{}) octopusdeploy.ChannelRule {
-	rule := octopusdeploy.ChannelRule{
-		VersionRange: tfRule["version_range"].(string),
-		Tag:          tfRule["tag"].(string),
-		Actions:      getSliceFromTerraformTypeList(tfRule["actions"]),
+func buildRulesResource(tfRule map[string]interface{}) channelRule {
+	rule := channelRule{
+		VersionRange:   tfRule["version_range"].(string),
+		Tag:            tfRule["tag"].(string),
+		ActionPackages: []deploymentActionPackage{},
+	}
+
+	for _, action := range getSliceFromTerraformTypeList(tfRule["actions"]) {
+		rule.ActionPackages = append(rule.ActionPackages, deploymentActionPackage{DeploymentAction: action})
+	}
+
+	if tfActionPackages, ok := tfRule["action_package"]; ok {
+		for _, tfActionPackage := range tfActionPackages.([]interface{}) {
+			tfActionPackageMap := tfActionPackage.(map[string]interface{})
+			rule.ActionPackages = append(rule.ActionPackages, deploymentActionPackage{
+				DeploymentAction: tfActionPackageMap["deployment_action"].(string),
+				PackageReference: tfActionPackageMap["package_reference"].(string),
+			})
+		}
 	}
 
 	return rule
 }
 
-func flattenRules(in []octopusdeploy.ChannelRule) []map[string]interface{} {
+var versionRangeBoundRegex = regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z.+_-]*$`)
+
+// validateVersionRange checks a version range is either a single minimum version, or NuGet/Maven interval
+// notation such as [1.0,2.0), (,1.0] or [1.0]. Maven ranges may be a comma separated list of intervals.
+func validateVersionRange(v interface{}, k string) (we []string, errors []error) {
+	versionRange := strings.TrimSpace(v.(string))
+	if versionRange == "" {
+		return
+	}
+
+	if !strings.ContainsAny(versionRange, "[]()") {
+		if !versionRangeBoundRegex.MatchString(versionRange) {
+			errors = append(errors, fmt.Errorf("%s: %q is not a valid version", k, versionRange))
+		}
+		return
+	}
+
+	for len(versionRange) > 0 {
+		end := strings.IndexAny(versionRange, "])")
+		if end == -1 {
+			errors = append(errors, fmt.Errorf("%s: %q is missing a closing ] or )", k, versionRange))
+			return
+		}
+
+		if err := validateVersionInterval(versionRange[:end+1]); err != nil {
+			errors = append(errors, fmt.Errorf("%s: %s", k, err.Error()))
+			return
+		}
+
+		versionRange = strings.TrimSpace(versionRange[end+1:])
+		if versionRange != "" {
+			if !strings.HasPrefix(versionRange, ",") {
+				errors = append(errors, fmt.Errorf("%s: intervals must be separated by a comma", k))
+				return
+			}
+			versionRange = strings.TrimSpace(versionRange[1:])
+			if versionRange == "" {
+				errors = append(errors, fmt.Errorf("%s: a trailing comma must be followed by another interval", k))
+				return
+			}
+		}
+	}
+
+	return
+}
+
+func validateVersionInterval(interval string) error {
+	start, end := interval[0], interval[len(interval)-1]
+	if start != '[' && start != '(' {
+		return fmt.Errorf("interval %q must start with [ or (", interval)
+	}
+
+	bounds := strings.Split(interval[1:len(interval)-1], ",")
+	for i := range bounds {
+		bounds[i] = strings.TrimSpace(bounds[i])
+		if bounds[i] != "" && !versionRangeBoundRegex.MatchString(bounds[i]) {
+			return fmt.Errorf("interval %q has an invalid version %q", interval, bounds[i])
+		}
+	}
+
+	switch len(bounds) {
+	case 1:
+		if start != '[' || end != ']' || bounds[0] == "" {
+			return fmt.Errorf("interval %q must be written as [version] to match an exact version", interval)
+		}
+	case 2:
+		if bounds[0] == "" && bounds[1] == "" {
+			return fmt.Errorf("interval %q must have a lower or an upper bound", interval)
+		}
+		if bounds[0] != "" && bounds[1] != "" {
+			order := compareVersions(bounds[0], bounds[1])
+			if order > 0 {
+				return fmt.Errorf("interval %q has a lower bound greater than its upper bound", interval)
+			}
+			if order == 0 && (start != '[' || end != ']') {
+				return fmt.Errorf("interval %q excludes its only version, and matches nothing", interval)
+			}
+		}
+	default:
+		return fmt.Errorf("interval %q must have at most a lower and an upper bound", interval)
+	}
+
+	return nil
+}
+
+// compareVersions orders two versions by their dot separated release numbers, then by pre-release tag, with
+// a version that has no tag ordered after the same version with one. Build metadata is ignored.
+func compareVersions(a, b string) int {
+	splitVersion := func(version string) ([]string, string) {
+		version = strings.SplitN(version, "+", 2)[0]
+		parts := strings.SplitN(version, "-", 2)
+		tag := ""
+		if len(parts) == 2 {
+			tag = parts[1]
+		}
+		return strings.Split(parts[0], "."), tag
+	}
+
+	aRelease, aTag := splitVersion(a)
+	bRelease, bTag := splitVersion(b)
+
+	for i := 0; i < len(aRelease) || i < len(bRelease); i++ {
+		aPart, bPart := "0", "0"
+		if i < len(aRelease) {
+			aPart = aRelease[i]
+		}
+		if i < len(bRelease) {
+			bPart = bRelease[i]
+		}
+
+		aNumber, aErr := strconv.Atoi(aPart)
+		bNumber, bErr := strconv.Atoi(bPart)
+		switch {
+		case aErr == nil && bErr == nil && aNumber != bNumber:
+			if aNumber < bNumber {
+				return -1
+			}
+			return 1
+		case (aErr != nil || bErr != nil) && aPart != bPart:
+			return strings.Compare(aPart, bPart)
+		}
+	}
+
+	switch {
+	case aTag == bTag:
+		return 0
+	case aTag == "":
+		return 1
+	case bTag == "":
+		return -1
+	}
+
+	return strings.Compare(aTag, bTag)
+}
+
+// validateTagRegex checks the pre-release tag compiles as a regular expression. Octopus evaluates the tag
+// with .NET regular expressions, so constructs Go doesn't support, such as lookarounds, are left to the server.
+func validateTagRegex(v interface{}, k string) (we []string, errors []error) {
+	if _, err := syntax.Parse(v.(string), syntax.Perl); err != nil {
+		if syntaxErr, ok := err.(*syntax.Error); ok && syntaxErr.Code == syntax.ErrInvalidPerlOp {
+			return
+		}
+		errors = append(errors, fmt.Errorf("%s: %q is not a valid regular expression: %s", k, v.(string), err.Error()))
+	}
+
+	return
+}
+
+// flattenRules reads primary packages back into actions, and named package references into action_package
+func flattenRules(in []channelRule) []map[string]interface{} {
 	var flattened = make([]map[string]interface{}, len(in), len(in))
 	for i, v := range in {
 		m := make(map[string]interface{})
 		m["version_range"] = v.VersionRange
 		m["tag"] = v.Tag
-		m["actions"] = v.Actions
+
+		actions := []string{}
+		actionPackages := []map[string]interface{}{}
+		for _, actionPackage := range v.ActionPackages {
+			if actionPackage.PackageReference == "" {
+				actions = append(actions, actionPackage.DeploymentAction)
+			} else {
+				actionPackages = append(actionPackages, map[string]interface{}{
+					"deployment_action": actionPackage.DeploymentAction,
+					"package_reference": actionPackage.PackageReference,
+				})
+			}
+		}
+		m["actions"] = actions
+		m["action_package"] = actionPackages
 
 		flattened[i] = m
 	}
@@ -121,10 +334,10 @@ func flattenRules(in []octopusdeploy.ChannelRule) []map[string]interface{} {
 }
 
 func resourceChannelRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	api := m.(*providerMeta).API
 
 	channelID := d.Id()
-	channel, err := client.Channel.Get(channelID)
+	channel, err := api.Channel.Get(channelID)
 
 	if err == octopusdeploy.ErrItemNotFound {
 		d.SetId("")
@@ -140,6 +353,7 @@ func resourceChannelRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", channel.Description)
 	d.Set("lifecycle_id", channel.LifecycleID)
 	d.Set("is_default", channel.IsDefault)
+	d.Set("tenant_tags", channel.TenantTags)
 	d.Set("rule", flattenRules(channel.Rules))
 
 	return nil
@@ -149,9 +363,9 @@ func resourceChannelUpdate(d *schema.ResourceData, m interface{}) error {
 	channel := buildChannelResource(d)
 	channel.ID = d.Id() // set channel struct ID so octopus knows which channel to update
 
-	client := m.(*octopusdeploy.Client)
+	api := m.(*providerMeta).API
 
-	updatedChannel, err := client.Channel.Update(channel)
+	updatedChannel, err := api.Channel.Update(channel)
 
 	if err != nil {
 		return fmt.Errorf("error updating channel id %s: %s", d.Id(), err.Error())
@@ -163,11 +377,11 @@ func resourceChannelUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceChannelDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	api := m.(*providerMeta).API
 
 	channelID := d.Id()
 
-	err := client.Channel.Delete(channelID)
+	err := api.Channel.Delete(channelID)
 
 	if err != nil {
 		return fmt.Errorf("error deleting channel id %s: %s", channelID, err.Error())
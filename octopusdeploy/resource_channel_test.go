@@ -2,6 +2,7 @@ package octopusdeploy
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
@@ -177,6 +178,90 @@ func TestAccOctopusDeployChannelWithTwoRules(t *testing.T) {
 	})
 }
 
+func TestAccOctopusDeployChannelWithInvalidRule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccChannelWithOneRule("Funky Channel", "this is Funky", "[1.0,2.0", "Funky Action"),
+				ExpectError: regexp.MustCompile("is missing a closing \\] or \\)"),
+			},
+			{
+				Config:      testAccChannelWithOneRule("Funky Channel", "this is Funky", "(,]", "Funky Action"),
+				ExpectError: regexp.MustCompile("must have a lower or an upper bound"),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployChannelWithPackageReferenceRule(t *testing.T) {
+	const terraformNamePrefix = "octopusdeploy_channel.ch"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelWithPackageReferenceRule(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOctopusDeployChannelExists(terraformNamePrefix),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "tenant_tags.0", "Funky Tags/Funky"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "rule.0.actions.0", "Funky Action"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "rule.0.action_package.0.deployment_action", "Funky Action"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "rule.0.action_package.0.package_reference", "Extra"),
+					resource.TestCheckResourceAttrPair(
+						"data.octopusdeploy_channel.ch", "id", terraformNamePrefix, "id"),
+					resource.TestCheckResourceAttr(
+						"data.octopusdeploy_channel.ch", "tenant_tags.0", "Funky Tags/Funky"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateVersionRange(t *testing.T) {
+	cases := []struct {
+		versionRange string
+		valid        bool
+	}{
+		{"", true},
+		{"1.0", true},
+		{"1.0.0-beta", true},
+		{"[1.0,2.0)", true},
+		{"(,1.0]", true},
+		{"[1.0,)", true},
+		{"[1.0]", true},
+		{"[1.0,1.0]", true},
+		{"[1.0-beta,1.0]", true},
+		{"[1.2,1.10]", true},
+		{"(,1.0],[1.2,)", true},
+		{"[2.0,1.0]", false},
+		{"[1.10,1.2]", false},
+		{"[1.0,1.0-beta]", false},
+		{"(1.0,1.0)", false},
+		{"[1.0,1.0)", false},
+		{"[1.0,2.0", false},
+		{"(,]", false},
+		{"(1.0)", false},
+		{"[1.0,2.0,3.0]", false},
+		{"[1.0,2.0),", false},
+		{"[1.0,2.0)[3.0,)", false},
+		{"1.0 beta", false},
+	}
+
+	for _, c := range cases {
+		_, errors := validateVersionRange(c.versionRange, "version_range")
+		if valid := len(errors) == 0; valid != c.valid {
+			t.Errorf("%q: expected valid to be %t, got errors %v", c.versionRange, c.valid, errors)
+		}
+	}
+}
+
 func testAccChannelBasic(name, description string) string {
 	return fmt.Sprintf(`
 
@@ -252,6 +337,76 @@ func testAccChannelWithOneRule(name, description, versionRange, actionName strin
 	)
 }
 
+func testAccChannelWithPackageReferenceRule() string {
+	return `
+		resource "octopusdeploy_project_group" "foo" {
+			name = "Integration Test Project Group"
+		}
+
+		resource "octopusdeploy_project" "foo" {
+			name           	= "funky project"
+			lifecycle_id	= "Lifecycles-1"
+			project_group_id = "${octopusdeploy_project_group.foo.id}"
+			allow_deployments_to_no_targets = true
+		}
+
+		resource "octopusdeploy_deployment_process" "deploy_step_template" {
+			project_id = "${octopusdeploy_project.foo.id}"
+			step {
+				name         = "step-1"
+				target_roles = ["Webserver"]
+				action {
+					name        = "Funky Action"
+					action_type = "Octopus.TentaclePackage"
+
+					primary_package {
+						package_id = "#{PackageName}"
+						feed_id    = "feeds-builtin"
+					}
+
+					package {
+						name       = "Extra"
+						package_id = "#{ExtraPackageName}"
+						feed_id    = "feeds-builtin"
+					}
+				}
+			}
+		}
+
+		resource "octopusdeploy_tag_set" "funky" {
+			name = "Funky Tags"
+
+			tag {
+				name  = "Funky"
+				color = "#6e6e6f"
+			}
+		}
+
+		resource "octopusdeploy_channel" "ch" {
+			name        = "Funky Channel"
+			project_id  = "${octopusdeploy_project.foo.id}"
+			tenant_tags = ["${octopusdeploy_tag_set.funky.name}/Funky"]
+
+			rule {
+				version_range = "[1.0,2.0)"
+				actions       = ["Funky Action"]
+
+				action_package {
+					deployment_action = "Funky Action"
+					package_reference = "Extra"
+				}
+			}
+
+			depends_on = ["octopusdeploy_deployment_process.deploy_step_template"]
+		}
+
+		data "octopusdeploy_channel" "ch" {
+			project_id = "${octopusdeploy_channel.ch.project_id}"
+			name       = "${octopusdeploy_channel.ch.name}"
+		}
+		`
+}
+
 func testAccChannelWithtwoRules(name, description, versionRange1, actionName1, versionRange2, actionName2 string) string {
 	return fmt.Sprintf(`
 
@@ -328,7 +483,7 @@ func testAccChannelWithtwoRules(name, description, versionRange1, actionName1, v
 
 func testAccCheckOctopusDeployChannelExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 		if err := existsHelperChannel(s, client); err != nil {
 			return err
 		}
@@ -348,7 +503,7 @@ func existsHelperChannel(s *terraform.State, client *octopusdeploy.Client) error
 }
 
 func testAccCheckOctopusDeployChannelDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	client := testAccProvider.Meta().(*providerMeta).Client
 
 	if err := destroyHelperChannel(s, client); err != nil {
 		return err
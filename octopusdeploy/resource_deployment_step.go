@@ -32,16 +32,43 @@ func resourceDeploymentStep_AddDefaultSchema(schemaRes *schema.Resource, target_
 	}
 
 	schemaRes.Schema["first_step"] = &schema.Schema{
-		Type:        schema.TypeBool,
-		Description: "Define as the first step",
-		Optional:    true,
-		Default:     false,
+		Type:          schema.TypeBool,
+		Description:   "Define as the first step",
+		Optional:      true,
+		Default:       false,
+		ConflictsWith: []string{"after_step_id", "before_step_id", "sort_order"},
 	}
 
 	schemaRes.Schema["after_step_id"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Description: "Define Step this should follow, else will be added to the end at time of creation",
-		Optional:    true,
+		Type:          schema.TypeString,
+		Description:   "Define Step this should follow, else will be added to the end at time of creation",
+		Optional:      true,
+		ConflictsWith: []string{"first_step", "before_step_id", "sort_order"},
+	}
+
+	schemaRes.Schema["before_step_id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Description:   "Define Step this should precede",
+		Optional:      true,
+		ConflictsWith: []string{"first_step", "after_step_id", "sort_order"},
+	}
+
+	schemaRes.Schema["sort_order"] = &schema.Schema{
+		Type:          schema.TypeInt,
+		Description:   "Define the zero based position of the step in the deployment process, among the steps in it when the step is written. -1 leaves the step where it is",
+		Optional:      true,
+		Default:       -1,
+		ConflictsWith: []string{"first_step", "after_step_id", "before_step_id"},
+	}
+
+	if schemaRes.CustomizeDiff == nil {
+		schemaRes.CustomizeDiff = resourceDeploymentStepCustomizeDiff
+	}
+
+	schemaRes.Schema["position"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "The zero based position of the step in the deployment process",
+		Computed:    true,
 	}
 
 	schemaRes.Schema["step_name"] = &schema.Schema{
@@ -141,12 +168,16 @@ func resourceDeploymentStep_AddPackageSchema(schemaRes *schema.Resource) {
 		},
 	}
 
+	schemaRes.Schema["custom_scripts"] = getCustomScriptsSchema()
+
 	schemaRes.Schema["pre_deploy_script"] = &schema.Schema{
-		Type:        schema.TypeSet,
-		MaxItems:    1,
-		MinItems:    1,
-		Description: "Custom Pre-deployment Script",
-		Optional:    true,
+		Type:          schema.TypeSet,
+		MaxItems:      1,
+		MinItems:      1,
+		Description:   "Custom Pre-deployment Script",
+		Optional:      true,
+		Deprecated:    "use custom_scripts instead",
+		ConflictsWith: []string{"custom_scripts"},
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"type": {
@@ -170,11 +201,13 @@ func resourceDeploymentStep_AddPackageSchema(schemaRes *schema.Resource) {
 	}
 
 	schemaRes.Schema["deploy_script"] = &schema.Schema{
-		Type:        schema.TypeSet,
-		MaxItems:    1,
-		MinItems:    1,
-		Description: "Custom Deployment Script",
-		Optional:    true,
+		Type:          schema.TypeSet,
+		MaxItems:      1,
+		MinItems:      1,
+		Description:   "Custom Deployment Script",
+		Optional:      true,
+		Deprecated:    "use custom_scripts instead",
+		ConflictsWith: []string{"custom_scripts"},
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"type": {
@@ -198,11 +231,13 @@ func resourceDeploymentStep_AddPackageSchema(schemaRes *schema.Resource) {
 	}
 
 	schemaRes.Schema["post_deploy_script"] = &schema.Schema{
-		Type:        schema.TypeSet,
-		MaxItems:    1,
-		MinItems:    1,
-		Description: "Custom Post-deployment Script",
-		Optional:    true,
+		Type:          schema.TypeSet,
+		MaxItems:      1,
+		MinItems:      1,
+		Description:   "Custom Post-deployment Script",
+		Optional:      true,
+		Deprecated:    "use custom_scripts instead",
+		ConflictsWith: []string{"custom_scripts"},
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"type": {
@@ -289,11 +324,13 @@ func resourceDeploymentStep_AddIisAppPoolSchema(schemaRes *schema.Resource) {
 /* Universal Create, Read, Update, Delete */
 /* --------------------------------------- */
 func resourceDeploymentStepCreate(d *schema.ResourceData, m interface{}, buildDeploymentProcessStepFunc func(d *schema.ResourceData) *octopusdeploy.DeploymentStep) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	projectId := d.Get("project_id").(string)
-	firstStep := d.Get("first_step").(bool)
-	afterStepId := d.Get("after_step_id").(string)
+
+	/* Steps are written by replacing the whole process, so writes to one process must not interleave */
+	lockDeploymentProcess(projectId)
+	defer unlockDeploymentProcess(projectId)
 
 	/* Find Deployment Process */
 	log.Printf("Loading Project Information '%s' ...", projectId)
@@ -314,28 +351,8 @@ func resourceDeploymentStepCreate(d *schema.ResourceData, m interface{}, buildDe
 	newDeploymentStep := buildDeploymentProcessStepFunc(d)
 
 	/* Add Step Appropiately into Deployment Steps */
-	orgDeploymentSteps := deploymentProcess.Steps
-
-	deploymentProcess.Steps = nil // empty the steps
-	newStepAddedIndex := -1
-	for stepIndex, orgDeploymentStep := range orgDeploymentSteps {
-		if firstStep && stepIndex == 0 {
-			newStepAddedIndex = stepIndex
-			deploymentProcess.Steps = append(deploymentProcess.Steps, *newDeploymentStep)
-		}
-
-		deploymentProcess.Steps = append(deploymentProcess.Steps, orgDeploymentStep)
-
-		if newStepAddedIndex == -1 && orgDeploymentStep.ID == afterStepId {
-			newStepAddedIndex = stepIndex + 1
-			deploymentProcess.Steps = append(deploymentProcess.Steps, *newDeploymentStep)
-		}
-	}
-
-	if newStepAddedIndex == -1 {
-		newStepAddedIndex = len(deploymentProcess.Steps)
-		deploymentProcess.Steps = append(deploymentProcess.Steps, *newDeploymentStep)
-	}
+	var newStepAddedIndex int
+	deploymentProcess.Steps, newStepAddedIndex = placeDeploymentStep(d, deploymentProcess.Steps, *newDeploymentStep, -1)
 
 	// Update Deployment Process with new Step
 	log.Printf("Updating Deployment Process '%s' ...", project.DeploymentProcessID)
@@ -351,14 +368,15 @@ func resourceDeploymentStepCreate(d *schema.ResourceData, m interface{}, buildDe
 	/* Set Ids */
 	d.SetId(updateDeploymentProcess.Steps[newStepAddedIndex].ID)
 	d.Set("deployment_process_id", updateDeploymentProcess.ID)
-	d.Set("enabled_features", updateDeploymentProcess.Steps[newStepAddedIndex].Actions[0].Properties["Octopus.Action.EnabledFeatures"])
+	d.Set("position", newStepAddedIndex)
+	d.Set("enabled_features", updateDeploymentProcess.Steps[newStepAddedIndex].Actions[0].Properties[enabledFeaturesProperty])
 
 	/* Return */
 	return nil
 }
 
-func resourceDeploymentStepRead(d *schema.ResourceData, m interface{}, setSchemaFunc func(d *schema.ResourceData, deploymentStep octopusdeploy.DeploymentStep)) error {
-	client := m.(*octopusdeploy.Client)
+func resourceDeploymentStepRead(d *schema.ResourceData, m interface{}, setSchemaFunc func(d *schema.ResourceData, deploymentStep octopusdeploy.DeploymentStep) error) error {
+	client := m.(*providerMeta).Client
 
 	/* Get Id's */
 	stepId := d.Id()
@@ -377,47 +395,67 @@ func resourceDeploymentStepRead(d *schema.ResourceData, m interface{}, setSchema
 		return fmt.Errorf("error reading deployment process '%s': %s", processId, err.Error())
 	}
 
-	var deploymentStep *octopusdeploy.DeploymentStep
-	var prevDeploymentStep *octopusdeploy.DeploymentStep
-	firstStep := false
-	for stepIndex, findDeploymentStep := range deploymentProcess.Steps {
+	stepIndex := -1
+	for findStepIndex, findDeploymentStep := range deploymentProcess.Steps {
 		if findDeploymentStep.ID == stepId {
-			deploymentStep = &findDeploymentStep
-			if stepIndex == 0 {
-				firstStep = true
-			}
+			stepIndex = findStepIndex
 			break
 		}
-
-		prevDeploymentStep = deploymentStep
 	}
 
-	if deploymentStep == nil {
+	if stepIndex == -1 {
 		d.SetId("")
 		return nil
 	}
 
-	d.Set("first_step", firstStep)
-	if prevDeploymentStep != nil {
-		d.Set("after_step_id", prevDeploymentStep.ID)
+	deploymentStep := deploymentProcess.Steps[stepIndex]
+
+	/* Only report the placement the configuration controls, so a step that isn't placed isn't changed */
+	d.Set("position", stepIndex)
+
+	if d.Get("first_step").(bool) {
+		d.Set("first_step", stepIndex == 0)
 	}
 
-	d.Set("enabled_features", deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"])
+	if _, ok := d.GetOk("after_step_id"); ok {
+		afterStepId := ""
+		if stepIndex > 0 {
+			afterStepId = deploymentProcess.Steps[stepIndex-1].ID
+		}
+		d.Set("after_step_id", afterStepId)
+	}
 
-	/* Set Schema */
-	setSchemaFunc(d, *deploymentStep)
+	if _, ok := d.GetOk("before_step_id"); ok {
+		beforeStepId := ""
+		if stepIndex < len(deploymentProcess.Steps)-1 {
+			beforeStepId = deploymentProcess.Steps[stepIndex+1].ID
+		}
+		d.Set("before_step_id", beforeStepId)
+	}
 
-	return nil
+	/* A sort order past the end of the process places the step last */
+	if sortOrder := d.Get("sort_order").(int); sortOrder >= 0 {
+		if sortOrder < len(deploymentProcess.Steps) || stepIndex != len(deploymentProcess.Steps)-1 {
+			d.Set("sort_order", stepIndex)
+		}
+	}
+
+	d.Set("enabled_features", deploymentStep.Actions[0].Properties[enabledFeaturesProperty])
+
+	/* Set Schema */
+	return setSchemaFunc(d, deploymentStep)
 }
 
 func resourceDeploymentStepUpdate(d *schema.ResourceData, m interface{}, buildDeploymentProcessStepFunc func(d *schema.ResourceData) *octopusdeploy.DeploymentStep) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	/* Get Id's */
 	stepId := d.Id()
 	processId := d.Get("deployment_process_id").(string)
-	firstStep := d.Get("first_step").(bool)
-	afterStepId := d.Get("after_step_id").(string)
+	projectId := d.Get("project_id").(string)
+
+	lockDeploymentProcess(projectId)
+	defer unlockDeploymentProcess(projectId)
 
 	/* Load Deployment Process */
 	log.Printf("Loading Deployment Process '%s' ...", processId)
@@ -436,31 +474,21 @@ func resourceDeploymentStepUpdate(d *schema.ResourceData, m interface{}, buildDe
 	newDeploymentStep := buildDeploymentProcessStepFunc(d)
 	newDeploymentStep.ID = stepId
 
-	/* Update Step */
+	/* Update Step, keeping its position unless the configuration places it */
 	orgDeploymentSteps := deploymentProcess.Steps
 	deploymentProcess.Steps = nil // empty the steps
 
-	newStepAddedIndex := -1
+	currentIndex := -1
 	for stepIndex, orgDeploymentStep := range orgDeploymentSteps {
-		if firstStep && stepIndex == 0 {
-			newStepAddedIndex = stepIndex
-			deploymentProcess.Steps = append(deploymentProcess.Steps, *newDeploymentStep)
-		}
-
-		if orgDeploymentStep.ID != stepId {
+		if orgDeploymentStep.ID == stepId {
+			currentIndex = stepIndex
+		} else {
 			deploymentProcess.Steps = append(deploymentProcess.Steps, orgDeploymentStep)
 		}
-
-		if newStepAddedIndex == -1 && orgDeploymentStep.ID == afterStepId {
-			newStepAddedIndex = stepIndex + 1
-			deploymentProcess.Steps = append(deploymentProcess.Steps, *newDeploymentStep)
-		}
 	}
 
-	if newStepAddedIndex == -1 {
-		newStepAddedIndex = len(deploymentProcess.Steps)
-		deploymentProcess.Steps = append(deploymentProcess.Steps, *newDeploymentStep)
-	}
+	var newStepAddedIndex int
+	deploymentProcess.Steps, newStepAddedIndex = placeDeploymentStep(d, deploymentProcess.Steps, *newDeploymentStep, currentIndex)
 
 	// Update Deployment Process with Step Removed
 	log.Printf("Updating Deployment Process '%s' ...", processId)
@@ -471,15 +499,21 @@ func resourceDeploymentStepUpdate(d *schema.ResourceData, m interface{}, buildDe
 		return fmt.Errorf("error updating deployment process for project: %s", err.Error())
 	}
 
+	d.Set("position", newStepAddedIndex)
+
 	return nil
 }
 
 func resourceDeploymentStepDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	/* Get Id's */
 	stepId := d.Id()
 	processId := d.Get("deployment_process_id").(string)
+	projectId := d.Get("project_id").(string)
+
+	lockDeploymentProcess(projectId)
+	defer unlockDeploymentProcess(projectId)
 
 	/* Load Deployment Process */
 	log.Printf("Loading Deployment Process '%s' ...", processId)
@@ -519,6 +553,68 @@ func resourceDeploymentStepDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+/* A step moved since it was written reads back a different placement, so show its position changing as well */
+func resourceDeploymentStepCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	for _, key := range []string{"first_step", "after_step_id", "before_step_id", "sort_order"} {
+		if d.HasChange(key) {
+			return d.SetNewComputed("position")
+		}
+	}
+
+	return nil
+}
+
+func lockDeploymentProcess(projectId string) {
+	octoMutex.Lock(fmt.Sprintf("deployment-process-%s", projectId))
+}
+
+func unlockDeploymentProcess(projectId string) {
+	octoMutex.Unlock(fmt.Sprintf("deployment-process-%s", projectId))
+}
+
+/* Insert the step where the configuration places it: first, after or before another step or at a sort order */
+/* among the other steps in the order of the deployment process. Otherwise the step keeps currentIndex when */
+/* updating, and is added to the end when creating */
+func placeDeploymentStep(d *schema.ResourceData, deploymentSteps []octopusdeploy.DeploymentStep, deploymentStep octopusdeploy.DeploymentStep, currentIndex int) ([]octopusdeploy.DeploymentStep, int) {
+	findStep := func(stepId string) int {
+		for stepIndex, findDeploymentStep := range deploymentSteps {
+			if findDeploymentStep.ID == stepId {
+				return stepIndex
+			}
+		}
+		log.Printf("[WARN] step %s was not found in the deployment process, step %s will be added to the end", stepId, deploymentStep.Name)
+		return len(deploymentSteps)
+	}
+
+	index := len(deploymentSteps)
+	if d.Get("first_step").(bool) {
+		index = 0
+	} else if afterStepId := d.Get("after_step_id").(string); afterStepId != "" {
+		index = findStep(afterStepId)
+		if index < len(deploymentSteps) {
+			index++
+		}
+	} else if beforeStepId := d.Get("before_step_id").(string); beforeStepId != "" {
+		index = findStep(beforeStepId)
+	} else if sortOrder := d.Get("sort_order").(int); sortOrder >= 0 {
+		if sortOrder < index {
+			index = sortOrder
+		}
+	} else if currentIndex >= 0 && currentIndex < index {
+		index = currentIndex
+	}
+
+	deploymentSteps = append(deploymentSteps, octopusdeploy.DeploymentStep{})
+	copy(deploymentSteps[index+1:], deploymentSteps[index:])
+	deploymentSteps[index] = deploymentStep
+
+	return deploymentSteps, index
+}
+
 /* --------------------------------------- */
 /* Shared Create Step Functions */
 /* --------------------------------------- */
@@ -607,9 +703,7 @@ func resourceDeploymentStep_AddPackageProperties_DeployScript(d *schema.Resource
 		/* Add action properties */
 		deploymentStep.Actions[0].Properties[fmt.Sprintf("Octopus.Action.CustomScripts.%s", scriptName)] = script["body"].(string)
 
-		if !strings.Contains(deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"], "Octopus.Features.CustomScripts") {
-			deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.CustomScripts"
-		}
+		enableFeatures(deploymentStep.Actions[0].Properties, featureCustomScripts)
 	}
 }
 
@@ -624,26 +718,28 @@ func resourceDeploymentStep_AddPackageProperties(d *schema.ResourceData, deploym
 		deploymentStep.Actions[0].Properties["Octopus.Action.Package.JsonConfigurationVariablesTargets"] = jsonFileVariableReplacement.(string)
 		deploymentStep.Actions[0].Properties["Octopus.Action.Package.JsonConfigurationVariablesEnabled"] = "True"
 
-		deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.JsonConfigurationVariables"
+		enableFeatures(deploymentStep.Actions[0].Properties, featureJsonConfigurationVariables)
 	}
 
 	if variableSubstitutionInFiles, ok := d.GetOk("variable_substitution_in_files"); ok {
 		deploymentStep.Actions[0].Properties["Octopus.Action.SubstituteInFiles.TargetFiles"] = strings.Join(getSliceFromTerraformTypeList(variableSubstitutionInFiles), "\n")
 		deploymentStep.Actions[0].Properties["Octopus.Action.SubstituteInFiles.Enabled"] = "True"
 
-		deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.SubstituteInFiles"
+		enableFeatures(deploymentStep.Actions[0].Properties, featureSubstituteInFiles)
 	}
 
 	if configurationTransforms := d.Get("configuration_transforms").(bool); configurationTransforms {
 		deploymentStep.Actions[0].Properties["Octopus.Action.Package.AutomaticallyRunConfigurationTransformationFiles"] = formatBool(configurationTransforms)
-		deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.ConfigurationTransforms"
+		enableFeatures(deploymentStep.Actions[0].Properties, featureConfigurationTransforms)
 	}
 
 	if configurationVariables := d.Get("configuration_variables").(bool); configurationVariables {
 		deploymentStep.Actions[0].Properties["Octopus.Action.Package.AutomaticallyUpdateAppSettingsAndConnectionStrings"] = formatBool(configurationVariables)
-		deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.ConfigurationVariables"
+		enableFeatures(deploymentStep.Actions[0].Properties, featureConfigurationVariables)
 	}
 
+	addCustomScriptsToProperties(d.Get("custom_scripts"), deploymentStep.Actions[0].Properties)
+
 	resourceDeploymentStep_AddPackageProperties_DeployScript(d, deploymentStep, "pre")
 	resourceDeploymentStep_AddPackageProperties_DeployScript(d, deploymentStep, "deploy")
 	resourceDeploymentStep_AddPackageProperties_DeployScript(d, deploymentStep, "post")
@@ -727,10 +823,10 @@ func resourceDeploymentStep_SetPackageSchema_DeployScript(d *schema.ResourceData
 		script["type"] = "PowerShell"
 		script["body"] = scriptValue
 	} else if scriptValue, ok := deploymentStep.Actions[0].Properties[fmt.Sprintf("Octopus.Action.CustomScripts.%s.sh", scriptNameStart)]; ok {
-		script["type"] = "CSharp"
+		script["type"] = "Bash"
 		script["body"] = scriptValue
 	} else if scriptValue, ok := deploymentStep.Actions[0].Properties[fmt.Sprintf("Octopus.Action.CustomScripts.%s.csx", scriptNameStart)]; ok {
-		script["type"] = "Bash"
+		script["type"] = "CSharp"
 		script["body"] = scriptValue
 	} else if scriptValue, ok := deploymentStep.Actions[0].Properties[fmt.Sprintf("Octopus.Action.CustomScripts.%s.fsx", scriptNameStart)]; ok {
 		script["type"] = "FSharp"
@@ -769,9 +865,14 @@ func resourceDeploymentStep_SetPackageSchema(d *schema.ResourceData, deploymentS
 		}
 	}
 
-	resourceDeploymentStep_SetPackageSchema_DeployScript(d, deploymentStep, "pre")
-	resourceDeploymentStep_SetPackageSchema_DeployScript(d, deploymentStep, "deploy")
-	resourceDeploymentStep_SetPackageSchema_DeployScript(d, deploymentStep, "post")
+	/* Custom scripts are read back into whichever attributes the configuration uses */
+	if _, ok := d.GetOk("custom_scripts"); ok {
+		d.Set("custom_scripts", flattenCustomScripts(deploymentStep.Actions[0].Properties))
+	} else {
+		resourceDeploymentStep_SetPackageSchema_DeployScript(d, deploymentStep, "pre")
+		resourceDeploymentStep_SetPackageSchema_DeployScript(d, deploymentStep, "deploy")
+		resourceDeploymentStep_SetPackageSchema_DeployScript(d, deploymentStep, "post")
+	}
 }
 
 func resourceDeploymentStep_SetIisAppPoolSchema(d *schema.ResourceData, deploymentStep octopusdeploy.DeploymentStep, iisType string) {
@@ -0,0 +1,156 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceDeploymentStepAction returns a standalone deployment step resource with a single action. The action
+// takes the attributes of the named action block of octopusdeploy_deployment_process, with the step name used
+// as the action name, and shares its build, validation and read back functions.
+func resourceDeploymentStepAction(actionBlock string, actionSchema *schema.Schema, buildActionFunc func(tfAction map[string]interface{}) octopusdeploy.DeploymentAction) *schema.Resource {
+	element := actionSchema.Elem.(*schema.Resource)
+
+	schemaRes := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+	}
+
+	/* Add Shared Schema's */
+	resourceDeploymentStep_AddDefaultSchema(schemaRes, false)
+
+	/* Add Action Schema, the step schema takes precedence for attributes both define */
+	for key, attrSchema := range element.Schema {
+		if _, ok := schemaRes.Schema[key]; !ok && key != "name" {
+			schemaRes.Schema[key] = attrSchema
+		}
+	}
+
+	getActionAttributes := func(get func(key string) interface{}) map[string]interface{} {
+		tfAction := map[string]interface{}{}
+		for key := range element.Schema {
+			if key == "name" {
+				tfAction[key] = get("step_name")
+			} else {
+				tfAction[key] = get(key)
+			}
+		}
+		return tfAction
+	}
+
+	buildStep := func(d *schema.ResourceData) *octopusdeploy.DeploymentStep {
+		deploymentStep := resourceDeploymentStep_CreateBasicStep(d, "")
+		deploymentStep.Actions[0] = buildActionFunc(getActionAttributes(d.Get))
+		return deploymentStep
+	}
+
+	setSchema := func(d *schema.ResourceData, deploymentStep octopusdeploy.DeploymentStep) error {
+		resourceDeploymentStep_SetBasicSchema(d, deploymentStep)
+
+		flattenFunc, ok := actionFlattenFuncs[actionBlock]
+		if !ok {
+			return nil
+		}
+
+		tfAction := getActionAttributes(d.Get)
+		flattenFunc(tfAction, deploymentStep.Actions[0].Properties)
+
+		for key, value := range tfAction {
+			if _, ok := schemaRes.Schema[key]; !ok {
+				continue
+			}
+			if err := d.Set(key, value); err != nil {
+				return fmt.Errorf("error setting %s of step %s: %s", key, deploymentStep.Name, err.Error())
+			}
+		}
+
+		return nil
+	}
+
+	schemaRes.Create = func(d *schema.ResourceData, m interface{}) error {
+		return resourceDeploymentStepCreate(d, m, buildStep)
+	}
+	schemaRes.Read = func(d *schema.ResourceData, m interface{}) error {
+		return resourceDeploymentStepRead(d, m, setSchema)
+	}
+	schemaRes.Update = func(d *schema.ResourceData, m interface{}) error {
+		return resourceDeploymentStepUpdate(d, m, buildStep)
+	}
+	schemaRes.Delete = resourceDeploymentStepDelete
+
+	schemaRes.CustomizeDiff = func(d *schema.ResourceDiff, m interface{}) error {
+		if err := resourceDeploymentStepCustomizeDiff(d, m); err != nil {
+			return err
+		}
+
+		if validateFunc, ok := actionValidateFuncs[actionBlock]; ok {
+			if err := validateFunc(getActionAttributes(d.Get)); err != nil {
+				return err
+			}
+		}
+
+		if validateFunc, ok := actionReferenceValidateFuncs[actionBlock]; ok {
+			if err := validateFunc(m.(*providerMeta).Client, getActionAttributes(d.Get)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	/* Return Schema */
+	return schemaRes
+}
+
+func resourceDeploymentStepRunScript() *schema.Resource {
+	return resourceDeploymentStepAction("run_script_action", getRunScriptActionSchema(), buildRunScriptActionResource)
+}
+
+func resourceDeploymentStepRunKubectlScript() *schema.Resource {
+	return resourceDeploymentStepAction("run_kubectl_script_action", getRunRunKubectlScriptSchema(), buildRunKubectlScriptActionResource)
+}
+
+func resourceDeploymentStepDeployKubernetesContainers() *schema.Resource {
+	return resourceDeploymentStepAction("deploy_kubernetes_containers_action", getDeployKubernetesContainersActionSchema(), buildDeployKubernetesContainersActionResource)
+}
+
+func resourceDeploymentStepDeployKubernetesSecret() *schema.Resource {
+	return resourceDeploymentStepAction("deploy_kubernetes_secret_action", getDeployKubernetesSecretActionSchema(), buildDeployKubernetesSecretActionResource)
+}
+
+func resourceDeploymentStepDeployKubernetesConfigMap() *schema.Resource {
+	return resourceDeploymentStepAction("deploy_kubernetes_config_map_action", getDeployKubernetesConfigMapActionSchema(), buildDeployKubernetesConfigMapActionResource)
+}
+
+func resourceDeploymentStepDeployRawKubernetesYaml() *schema.Resource {
+	return resourceDeploymentStepAction("deploy_raw_kubernetes_yaml_action", getDeployRawKubernetesYamlActionSchema(), buildDeployRawKubernetesYamlActionResource)
+}
+
+func resourceDeploymentStepUpgradeHelmChart() *schema.Resource {
+	return resourceDeploymentStepAction("upgrade_helm_chart_action", getUpgradeHelmChartActionSchema(), buildUpgradeHelmChartActionResource)
+}
+
+func resourceDeploymentStepApplyTerraform() *schema.Resource {
+	return resourceDeploymentStepAction("apply_terraform_action", getTerraformActionSchema(), buildApplyTerraformActionResource)
+}
+
+func resourceDeploymentStepPlanTerraform() *schema.Resource {
+	return resourceDeploymentStepAction("plan_terraform_action", getTerraformActionSchema(), buildPlanTerraformActionResource)
+}
+
+func resourceDeploymentStepDestroyTerraform() *schema.Resource {
+	return resourceDeploymentStepAction("destroy_terraform_action", getTerraformActionSchema(), buildDestroyTerraformActionResource)
+}
+
+func resourceDeploymentStepPlanDestroyTerraform() *schema.Resource {
+	return resourceDeploymentStepAction("plan_destroy_terraform_action", getTerraformActionSchema(), buildPlanDestroyTerraformActionResource)
+}
+
+func resourceDeploymentStepManualIntervention() *schema.Resource {
+	return resourceDeploymentStepAction("manual_intervention_action", getManualInterventionActionSchema(), buildManualInterventionActionResource)
+}
+
+func resourceDeploymentStepDeployWindowsService() *schema.Resource {
+	return resourceDeploymentStepAction("deploy_windows_service_action", getDeployWindowsServiceActionSchema(), buildDeployWindowsServiceActionResource)
+}
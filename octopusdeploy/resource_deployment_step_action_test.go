@@ -0,0 +1,165 @@
+package octopusdeploy
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOctopusDeployDeploymentStepRunScriptBasic(t *testing.T) {
+	const terraformNamePrefix = "octopusdeploy_deployment_step_run_script.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentStepRunScriptBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "step_name", "Run Script"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "script_body", "Write-Host 'Hello'"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "run_on_server", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployDeploymentStepManualInterventionBasic(t *testing.T) {
+	const terraformNamePrefix = "octopusdeploy_deployment_step_manual_intervention.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentStepManualInterventionBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "step_name", "Approve"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "instructions", "Approve the release"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployDeploymentStepSortOrder(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentStepSortOrder(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"octopusdeploy_deployment_step_run_script.first", "position", "0"),
+					resource.TestCheckResourceAttr(
+						"octopusdeploy_deployment_step_run_script.second", "position", "1"),
+					resource.TestCheckResourceAttr(
+						"octopusdeploy_deployment_step_manual_intervention.approve", "position", "2"),
+				),
+			},
+			{
+				// Reordering the process outside Terraform shows in the plan
+				PreConfig:          testAccSwapFirstDeploymentSteps(t, "Project-000"),
+				Config:             testAccDeploymentStepSortOrder(),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      testAccDeploymentStepSortOrderConflict(),
+				ExpectError: regexp.MustCompile("conflicts with"),
+			},
+		},
+	})
+}
+
+func testAccDeploymentStepSortOrder() string {
+	// The steps are written approve, first then second, so each step after approve is placed among steps that
+	// are already in the process
+	return `
+		resource "octopusdeploy_deployment_step_manual_intervention" "approve" {
+			project_id   = "Project-000"
+			step_name    = "Approve"
+			sort_order   = 2
+			instructions = "Approve the release"
+		}
+
+		resource "octopusdeploy_deployment_step_run_script" "first" {
+			project_id  = "Project-000"
+			step_name   = "First"
+			sort_order  = 0
+			script_body = "Write-Host 'First'"
+			depends_on  = ["octopusdeploy_deployment_step_manual_intervention.approve"]
+		}
+
+		resource "octopusdeploy_deployment_step_run_script" "second" {
+			project_id  = "Project-000"
+			step_name   = "Second"
+			sort_order  = 1
+			script_body = "Write-Host 'Second'"
+			depends_on  = ["octopusdeploy_deployment_step_run_script.first"]
+		}
+	`
+}
+
+// testAccSwapFirstDeploymentSteps swaps the first two steps of the project's deployment process
+func testAccSwapFirstDeploymentSteps(t *testing.T, projectId string) func() {
+	return func() {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		project, err := client.Project.Get(projectId)
+		if err != nil {
+			t.Fatalf("error getting project %s: %s", projectId, err.Error())
+		}
+
+		deploymentProcess, err := client.DeploymentProcess.Get(project.DeploymentProcessID)
+		if err != nil {
+			t.Fatalf("error getting deployment process %s: %s", project.DeploymentProcessID, err.Error())
+		}
+
+		deploymentProcess.Steps[0], deploymentProcess.Steps[1] = deploymentProcess.Steps[1], deploymentProcess.Steps[0]
+		if _, err := client.DeploymentProcess.Update(deploymentProcess); err != nil {
+			t.Fatalf("error updating deployment process %s: %s", project.DeploymentProcessID, err.Error())
+		}
+	}
+}
+
+func testAccDeploymentStepSortOrderConflict() string {
+	return `
+		resource "octopusdeploy_deployment_step_run_script" "first" {
+			project_id  = "Project-000"
+			step_name   = "First"
+			first_step  = true
+			sort_order  = 0
+			script_body = "Write-Host 'First'"
+		}
+	`
+}
+
+func testAccDeploymentStepRunScriptBasic() string {
+	return `
+		resource "octopusdeploy_deployment_step_run_script" "foo" {
+			project_id    = "Project-000"
+			step_name     = "Run Script"
+			run_on_server = true
+			script_body   = "Write-Host 'Hello'"
+		}
+	`
+}
+
+func testAccDeploymentStepManualInterventionBasic() string {
+	return `
+		resource "octopusdeploy_deployment_step_manual_intervention" "foo" {
+			project_id   = "Project-000"
+			step_name    = "Approve"
+			instructions = "Approve the release"
+		}
+	`
+}
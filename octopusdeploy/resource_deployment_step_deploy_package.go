@@ -34,9 +34,11 @@ func buildDeployPackageDeploymentStep(d *schema.ResourceData) *octopusdeploy.Dep
 	return deploymentStep
 }
 
-func setDeployPackageSchema(d *schema.ResourceData, deploymentStep octopusdeploy.DeploymentStep) {
+func setDeployPackageSchema(d *schema.ResourceData, deploymentStep octopusdeploy.DeploymentStep) error {
 	resourceDeploymentStep_SetBasicSchema(d, deploymentStep);
 	resourceDeploymentStep_SetPackageSchema(d, deploymentStep);
+
+	return nil
 }
 
 func resourceDeploymentStepDeployPackageCreate(d *schema.ResourceData, m interface{}) error {
@@ -0,0 +1,125 @@
+package octopusdeploy
+
+import (
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceDeploymentStepIisVirtualDirectory() *schema.Resource {
+	schemaRes := &schema.Resource{
+		Create: resourceDeploymentStepIisVirtualDirectoryCreate,
+		Read:   resourceDeploymentStepIisVirtualDirectoryRead,
+		Update: resourceDeploymentStepIisVirtualDirectoryUpdate,
+		Delete: resourceDeploymentStepIisVirtualDirectoryDelete,
+
+		Schema: map[string]*schema.Schema{
+			"deployment_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"website_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the Website to add the virtual directory to",
+				Required:    true,
+			},
+			"virtual_path": {
+				Type:        schema.TypeString,
+				Description: "Virtual Path for the Virtual Directory",
+				Required:    true,
+			},
+			"path_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"relative_path": {
+				Type:        schema.TypeString,
+				Description: "Relative Path to package Root for the physical Path",
+				Optional:    true,
+			},
+		},
+	}
+
+	/* Add Shared Schema's */
+	resourceDeploymentStep_AddDefaultSchema(schemaRes, true)
+	resourceDeploymentStep_AddPackageSchema(schemaRes)
+
+	/* Return Schema */
+	return schemaRes
+}
+
+func buildIisVirtualDirectoryDeploymentStep(d *schema.ResourceData) *octopusdeploy.DeploymentStep {
+	/* Set Computed Values */
+	d.Set("deployment_type", "virtualDirectory")
+
+	/* Create Basic Deployment Step */
+	deploymentStep := resourceDeploymentStep_CreateBasicStep(d, "Octopus.IIS")
+
+	/* Enable IIS Web Site Features */
+	enableFeatures(deploymentStep.Actions[0].Properties, featureIISWebSite)
+
+	/* Add Shared Properties */
+	resourceDeploymentStep_AddPackageProperties(d, deploymentStep)
+
+	/* Add Virtual Directory Properties */
+	deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.DeploymentType"] = d.Get("deployment_type").(string)
+	deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.CreateOrUpdateWebSite"] = "False"
+	deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.WebApplication.CreateOrUpdate"] = "False"
+	deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.VirtualDirectory.CreateOrUpdate"] = "True"
+
+	if relativePath, ok := d.GetOk("relative_path"); ok {
+		d.Set("path_type", "relativeToPackageRoot")
+		deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.VirtualDirectory.PhysicalPath"] = relativePath.(string)
+	} else {
+		d.Set("path_type", "packageRoot")
+	}
+	deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.WebRootType"] = d.Get("path_type").(string)
+	deploymentStep.Actions[0].Properties["Octopus.Action.VirtualDirectory.WebRootType"] = d.Get("path_type").(string)
+
+	deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.VirtualDirectory.WebSiteName"] = d.Get("website_name").(string)
+	deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.VirtualDirectory.VirtualPath"] = d.Get("virtual_path").(string)
+
+	/* Return Deployment Step */
+	return deploymentStep
+}
+
+func setIisVirtualDirectorySchema(d *schema.ResourceData, deploymentStep octopusdeploy.DeploymentStep) error {
+	resourceDeploymentStep_SetBasicSchema(d, deploymentStep)
+	resourceDeploymentStep_SetPackageSchema(d, deploymentStep)
+
+	/* Get Virtual Directory Properties */
+	d.Set("deployment_type", deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.DeploymentType"])
+
+	if pathType, ok := deploymentStep.Actions[0].Properties["Octopus.Action.VirtualDirectory.WebRootType"]; ok {
+		d.Set("path_type", pathType)
+	}
+
+	if relativePath, ok := deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.VirtualDirectory.PhysicalPath"]; ok {
+		d.Set("relative_path", relativePath)
+	}
+
+	if websiteName, ok := deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.VirtualDirectory.WebSiteName"]; ok {
+		d.Set("website_name", websiteName)
+	}
+
+	if virtualPath, ok := deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.VirtualDirectory.VirtualPath"]; ok {
+		d.Set("virtual_path", virtualPath)
+	}
+
+	return nil
+}
+
+func resourceDeploymentStepIisVirtualDirectoryCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceDeploymentStepCreate(d, m, buildIisVirtualDirectoryDeploymentStep)
+}
+
+func resourceDeploymentStepIisVirtualDirectoryRead(d *schema.ResourceData, m interface{}) error {
+	return resourceDeploymentStepRead(d, m, setIisVirtualDirectorySchema)
+}
+
+func resourceDeploymentStepIisVirtualDirectoryUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceDeploymentStepUpdate(d, m, buildIisVirtualDirectoryDeploymentStep)
+}
+
+func resourceDeploymentStepIisVirtualDirectoryDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceDeploymentStepDelete(d, m)
+}
@@ -0,0 +1,51 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOctopusDeployDeploymentStepIisVirtualDirectoryBasic(t *testing.T) {
+	const terraformNamePrefix = "octopusdeploy_deployment_step_iis_virtual_directory.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentStepIisVirtualDirectoryBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "step_name", "Deploy Virtual Directory"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "deployment_type", "virtualDirectory"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "website_name", "Default Web Site"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "virtual_path", "/docs"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "path_type", "relativeToPackageRoot"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentStepIisVirtualDirectoryBasic() string {
+	return `
+		resource "octopusdeploy_deployment_step_iis_virtual_directory" "foo" {
+			project_id    = "Project-000"
+			step_name     = "Deploy Virtual Directory"
+			feed_id       = "Feed-000"
+			package       = "docs.yolo"
+			website_name  = "Default Web Site"
+			virtual_path  = "/docs"
+			relative_path = "content"
+
+			target_roles = [
+				"MyRole1",
+			]
+		}
+	`
+}
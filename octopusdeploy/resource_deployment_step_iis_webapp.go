@@ -56,7 +56,7 @@ func buildIisWebappDeploymentStep(d *schema.ResourceData) *octopusdeploy.Deploym
 	deploymentStep := resourceDeploymentStep_CreateBasicStep(d, "Octopus.IIS")
 
 	/* Enable IIS Web Site Features */
-	deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] = "Octopus.Features.IISWebSite"
+	enableFeatures(deploymentStep.Actions[0].Properties, featureIISWebSite)
 
 	/* Add Shared Properties */
 	resourceDeploymentStep_AddPackageProperties(d, deploymentStep)
@@ -85,7 +85,7 @@ func buildIisWebappDeploymentStep(d *schema.ResourceData) *octopusdeploy.Deploym
 	return deploymentStep
 }
 
-func setIisWebappSchema(d *schema.ResourceData, deploymentStep octopusdeploy.DeploymentStep) {
+func setIisWebappSchema(d *schema.ResourceData, deploymentStep octopusdeploy.DeploymentStep) error {
 	resourceDeploymentStep_SetBasicSchema(d, deploymentStep)
 	resourceDeploymentStep_SetPackageSchema(d, deploymentStep)
 	resourceDeploymentStep_SetIisAppPoolSchema(d, deploymentStep, "WebApplication")
@@ -108,6 +108,8 @@ func setIisWebappSchema(d *schema.ResourceData, deploymentStep octopusdeploy.Dep
 	if virtualPath, ok := deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.WebApplication.VirtualPath"]; ok {
 		d.Set("virtual_path", virtualPath)
 	}
+
+	return nil
 }
 
 func resourceDeploymentStepIisWebappCreate(d *schema.ResourceData, m interface{}) error {
@@ -2,11 +2,15 @@ package octopusdeploy
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
+	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/config/hcl2shim"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -17,6 +21,8 @@ func resourceDeploymentStepIisWebsite() *schema.Resource {
 		Update: resourceDeploymentStepIisWebsiteUpdate,
 		Delete: resourceDeploymentStepIisWebsiteDelete,
 
+		CustomizeDiff: resourceDeploymentStepIisWebsiteCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"website_name": {
 				Type:        schema.TypeString,
@@ -60,69 +66,26 @@ func resourceDeploymentStepIisWebsite() *schema.Resource {
 				Description: "Whether IIS should allow integrated Windows authentication with a 401 challenge.",
 				Default:     true,
 			},
-			"binding": &schema.Schema{
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"protocol": {
-							Type:        schema.TypeString,
-							Description: "Protocol to bind to",
-							Optional:    true,
-							Default:     "https",
-							ValidateFunc: validateValueFunc([]string{
-								"http",
-								"https",
-							}),
-						},
-						"ip": {
-							Type:        schema.TypeString,
-							Description: "IP Address to bind to",
-							Optional:    true,
-							Default:     "*",
-						},
-						"port": {
-							Type:        schema.TypeString,
-							Description: "Port to bind to",
-							Optional:    true,
-							Default:     "*",
-						},
-						"host": {
-							Type:        schema.TypeString,
-							Description: "Host Name to bind to",
-							Optional:    true,
-							Default:     "",
-						},
-						"enable": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Description: "Enable the binding",
-							Default:     true,
-						},
-						"thumbprint": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Thumbprint for the SSL Binding",
-							Default:     "",
-						},
-						"cert_var": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Certicate Variable Name for the SSL Binding",
-							Default:     "",
-						},
-						"require_sni": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Description: "Require Service Name Identification for the SSL binding",
-							Default:     false,
-						},
-					},
-				},
-			},
+			"binding": getIisBindingSchema(),
 		},
 	}
 
+	/* cert_var and enable were renamed, and are kept for configurations that still use them */
+	bindingSchema := schemaRes.Schema["binding"].Elem.(*schema.Resource).Schema
+	bindingSchema["cert_var"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Certificate Variable Name for the SSL Binding",
+		Deprecated:  "use certificate_variable instead",
+	}
+	bindingSchema["enable"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Enable the binding",
+		Deprecated:  "use enabled instead",
+		Default:     true,
+	}
+
 	/* Add Shared Schema's */
 	resourceDeploymentStep_AddDefaultSchema(schemaRes, true)
 	resourceDeploymentStep_AddPackageSchema(schemaRes)
@@ -132,6 +95,71 @@ func resourceDeploymentStepIisWebsite() *schema.Resource {
 	return schemaRes
 }
 
+/* The bindings of an IIS web site, shared with the iis_web_site feature of deploy_package_action */
+func getIisBindingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"protocol": {
+					Type:        schema.TypeString,
+					Description: "Protocol to bind to",
+					Optional:    true,
+					Default:     "https",
+					ValidateFunc: validateValueFunc([]string{
+						"http",
+						"https",
+					}),
+				},
+				"ip": {
+					Type:        schema.TypeString,
+					Description: "IP Address to bind to",
+					Optional:    true,
+					Default:     "*",
+				},
+				"port": {
+					Type:        schema.TypeString,
+					Description: "Port to bind to",
+					Optional:    true,
+					Default:     "*",
+				},
+				"host": {
+					Type:         schema.TypeString,
+					Description:  "Host Name to bind to",
+					Optional:     true,
+					Default:      "",
+					ValidateFunc: validateIisBindingHost,
+				},
+				"enabled": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Enable the binding",
+					Default:     true,
+				},
+				"thumbprint": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Thumbprint for the SSL Binding",
+					Default:     "",
+				},
+				"certificate_variable": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Certificate Variable Name for the SSL Binding",
+					Default:     "",
+				},
+				"require_sni": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Require Service Name Identification for the SSL binding",
+					Default:     false,
+				},
+			},
+		},
+	}
+}
+
 func buildIisWebsiteDeploymentStep(d *schema.ResourceData) *octopusdeploy.DeploymentStep {
 	/* Set Computed Values */
 	d.Set("deployment_type", "webSite")
@@ -140,7 +168,7 @@ func buildIisWebsiteDeploymentStep(d *schema.ResourceData) *octopusdeploy.Deploy
 	deploymentStep := resourceDeploymentStep_CreateBasicStep(d, "Octopus.IIS")
 
 	/* Enable IIS Web Site Feature */
-	deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] = "Octopus.Features.IISWebSite"
+	enableFeatures(deploymentStep.Actions[0].Properties, featureIISWebSite)
 
 	/* Add Shared Properties */
 	resourceDeploymentStep_AddPackageProperties(d, deploymentStep)
@@ -167,68 +195,27 @@ func buildIisWebsiteDeploymentStep(d *schema.ResourceData) *octopusdeploy.Deploy
 	deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.EnableBasicAuthentication"] = formatBool(d.Get("basic_authentication").(bool))
 	deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.EnableWindowsAuthentication"] = formatBool(d.Get("windows_authentication").(bool))
 
-	/* Flatten Bindings */
-	type bindingsStruct struct {
-		Protocol            *string `json:"protocol"`
-		IpAddress           *string `json:"ipAddress"`
-		Port                *string `json:"port"`
-		Host                *string `json:"host"`
-		Thumbprint          *string `json:"thumbprint"`
-		CertificateVariable *string `json:"certificateVariable"`
-		RequireSni          bool    `json:"requireSni"`
-		Enabled             bool    `json:"enabled"`
-	}
+	/* Flatten Bindings, taking the deprecated cert_var and enable where they are set */
+	tfBindings := d.Get("binding").([]interface{})
+	for _, rawBinding := range tfBindings {
+		binding := rawBinding.(map[string]interface{})
 
-	bindingsArray := []bindingsStruct{}
-
-	if rawBindings, ok := d.GetOk("binding"); ok {
-		bindings := rawBindings.([]interface{})
-
-		for _, rawBinding := range bindings {
-			binding := rawBinding.(map[string]interface{})
-
-			bindingsArray = append(bindingsArray, bindingsStruct{
-				formatStrPtr(binding["protocol"].(string)),
-				formatStrPtr(binding["ip"].(string)),
-				formatStrPtr(binding["port"].(string)),
-				formatStrPtr(binding["host"].(string)),
-				formatStrPtr(binding["thumbprint"].(string)),
-				formatStrPtr(binding["cert_var"].(string)),
-				binding["require_sni"].(bool),
-				binding["enable"].(bool),
-			})
+		if certVar := binding["cert_var"].(string); certVar != "" {
+			binding["certificate_variable"] = certVar
 		}
-	} else {
-		log.Printf("rawBindings: %+v", rawBindings)
-		log.Printf("getBindingsOk: %t", ok)
 
-		/* Add Default HTTP 80 binding */
-		bindingsArray = append(bindingsArray, bindingsStruct{
-			formatStrPtr("http"),
-			formatStrPtr("*"),
-			formatStrPtr("80"),
-			formatStrPtr(""),
-			formatStrPtr(""),
-			formatStrPtr(""),
-			false,
-			true,
-		})
+		if !binding["enable"].(bool) {
+			binding["enabled"] = false
+		}
 	}
 
-	log.Printf("bindingsArray: %+v", bindingsArray)
-
-	bindingsBytes, _ := json.Marshal(bindingsArray)
-	bindingsString := strings.ReplaceAll(string(bindingsBytes), "\"", "\\\"")
-
-	log.Printf("bindingsString: %s", bindingsString)
-
-	deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.Bindings"] = string(bindingsBytes)
+	deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.Bindings"] = buildIisBindingsProperty(tfBindings)
 
 	/* Return Deployment Step */
 	return deploymentStep
 }
 
-func setIisWebsiteSchema(d *schema.ResourceData, deploymentStep octopusdeploy.DeploymentStep) {
+func setIisWebsiteSchema(d *schema.ResourceData, deploymentStep octopusdeploy.DeploymentStep) error {
 	resourceDeploymentStep_SetBasicSchema(d, deploymentStep)
 	resourceDeploymentStep_SetPackageSchema(d, deploymentStep)
 	resourceDeploymentStep_SetIisAppPoolSchema(d, deploymentStep, "IISWebSite")
@@ -272,8 +259,219 @@ func setIisWebsiteSchema(d *schema.ResourceData, deploymentStep octopusdeploy.De
 		}
 	}
 
-	/* TODO: Expand Bindings */
-	// deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.Bindings"]
+	/* Expand Bindings */
+	if bindingsString, ok := deploymentStep.Actions[0].Properties["Octopus.Action.IISWebSite.Bindings"]; ok {
+		tfBindings := d.Get("binding").([]interface{})
+
+		if bindings, err := flattenConfiguredIisBindings(bindingsString, tfBindings); err == nil {
+			/* Bindings configured with the deprecated cert_var and enable read back into them */
+			for i, rawBinding := range bindings {
+				binding := rawBinding.(map[string]interface{})
+				binding["enable"] = true
+
+				if i >= len(tfBindings) {
+					continue
+				}
+
+				tfBinding := tfBindings[i].(map[string]interface{})
+
+				if certVar, _ := tfBinding["cert_var"].(string); certVar != "" {
+					binding["cert_var"] = binding["certificate_variable"]
+					binding["certificate_variable"] = ""
+				}
+
+				if enable, ok := tfBinding["enable"].(bool); ok && !enable {
+					binding["enable"] = binding["enabled"]
+					binding["enabled"] = true
+				}
+			}
+
+			if err := d.Set("binding", bindings); err != nil {
+				return fmt.Errorf("error setting bindings of step %s: %s", deploymentStep.Name, err.Error())
+			}
+		} else {
+			log.Printf("[WARN] unable to parse IIS bindings of step %s: %s", deploymentStep.Name, err.Error())
+		}
+	}
+
+	return nil
+}
+
+type iisBinding struct {
+	Protocol            *string `json:"protocol"`
+	IpAddress           *string `json:"ipAddress"`
+	Port                *string `json:"port"`
+	Host                *string `json:"host"`
+	Thumbprint          *string `json:"thumbprint"`
+	CertificateVariable *string `json:"certificateVariable"`
+	RequireSni          bool    `json:"requireSni"`
+	Enabled             bool    `json:"enabled"`
+}
+
+/* Without any bindings configured the web site is bound to port 80 over http */
+func buildIisBindingsProperty(tfBindings []interface{}) string {
+	bindingsArray := []iisBinding{}
+
+	for _, rawBinding := range tfBindings {
+		binding := rawBinding.(map[string]interface{})
+
+		bindingsArray = append(bindingsArray, iisBinding{
+			formatStrPtr(binding["protocol"].(string)),
+			formatStrPtr(binding["ip"].(string)),
+			formatStrPtr(binding["port"].(string)),
+			formatStrPtr(binding["host"].(string)),
+			formatStrPtr(binding["thumbprint"].(string)),
+			formatStrPtr(binding["certificate_variable"].(string)),
+			binding["require_sni"].(bool),
+			binding["enabled"].(bool),
+		})
+	}
+
+	if len(bindingsArray) == 0 {
+		bindingsArray = append(bindingsArray, iisBinding{
+			formatStrPtr("http"),
+			formatStrPtr("*"),
+			formatStrPtr("80"),
+			formatStrPtr(""),
+			formatStrPtr(""),
+			formatStrPtr(""),
+			false,
+			true,
+		})
+	}
+
+	bindingsBytes, _ := json.Marshal(bindingsArray)
+	return string(bindingsBytes)
+}
+
+/* Bindings saved by the Octopus UI may hold the booleans and the port as strings */
+func flattenIisBindings(bindingsString string) ([]interface{}, error) {
+	var rawBindings []map[string]interface{}
+	if err := json.Unmarshal([]byte(bindingsString), &rawBindings); err != nil {
+		return nil, err
+	}
+
+	bindingString := func(rawBinding map[string]interface{}, key string) string {
+		switch value := rawBinding[key].(type) {
+		case nil:
+			return ""
+		case string:
+			return value
+		default:
+			return fmt.Sprint(value)
+		}
+	}
+
+	bindingBool := func(rawBinding map[string]interface{}, key string, defaultValue bool) bool {
+		switch value := rawBinding[key].(type) {
+		case bool:
+			return value
+		case string:
+			if parsed, err := strconv.ParseBool(value); err == nil {
+				return parsed
+			}
+		}
+		return defaultValue
+	}
+
+	bindings := []interface{}{}
+	for _, rawBinding := range rawBindings {
+		bindings = append(bindings, map[string]interface{}{
+			"protocol":             bindingString(rawBinding, "protocol"),
+			"ip":                   bindingString(rawBinding, "ipAddress"),
+			"port":                 bindingString(rawBinding, "port"),
+			"host":                 bindingString(rawBinding, "host"),
+			"thumbprint":           bindingString(rawBinding, "thumbprint"),
+			"certificate_variable": bindingString(rawBinding, "certificateVariable"),
+			"require_sni":          bindingBool(rawBinding, "requireSni", false),
+			"enabled":              bindingBool(rawBinding, "enabled", true),
+		})
+	}
+
+	return bindings, nil
+}
+
+/* A web site without bindings is given the default binding, which reads back as no bindings */
+func flattenConfiguredIisBindings(bindingsString string, tfBindings []interface{}) ([]interface{}, error) {
+	bindings, err := flattenIisBindings(bindingsString)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tfBindings) == 0 {
+		defaultBindings, _ := flattenIisBindings(buildIisBindingsProperty(nil))
+		if reflect.DeepEqual(bindings, defaultBindings) {
+			return []interface{}{}, nil
+		}
+	}
+
+	return bindings, nil
+}
+
+var iisBindingHostRegex = regexp.MustCompile(`^(\*\.)?[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?(\.[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?)*$`)
+
+/* Host names may use a leading wildcard, and Octopus variables are left to be checked at deployment */
+func validateIisBindingHost(v interface{}, k string) (we []string, errors []error) {
+	host := v.(string)
+	if host == "" || strings.Contains(host, "#{") {
+		return
+	}
+
+	if len(host) > 253 || !iisBindingHostRegex.MatchString(host) {
+		errors = append(errors, fmt.Errorf("%s: %q is not a valid host name", k, host))
+	}
+
+	return
+}
+
+func resourceDeploymentStepIisWebsiteCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if err := resourceDeploymentStepCustomizeDiff(d, m); err != nil {
+		return err
+	}
+
+	return validateIisBindings(d.Get("binding").([]interface{}))
+}
+
+/* Check the certificate and SNI settings of each binding suit its protocol */
+func validateIisBindings(tfBindings []interface{}) error {
+	for i, rawBinding := range tfBindings {
+		binding := rawBinding.(map[string]interface{})
+
+		protocol := binding["protocol"].(string)
+		host := binding["host"].(string)
+		thumbprint := binding["thumbprint"].(string)
+		certificateVariable := binding["certificate_variable"].(string)
+
+		if certVar, ok := binding["cert_var"].(string); ok && certVar != "" {
+			if certificateVariable != "" {
+				return fmt.Errorf("binding %d: only one of cert_var and certificate_variable can be set", i)
+			}
+			certificateVariable = certVar
+		}
+
+		if protocol == hcl2shim.UnknownVariableValue || thumbprint == hcl2shim.UnknownVariableValue || certificateVariable == hcl2shim.UnknownVariableValue {
+			continue
+		}
+
+		if protocol == "https" {
+			if thumbprint != "" && certificateVariable != "" {
+				return fmt.Errorf("binding %d: only one of thumbprint and certificate_variable can be set", i)
+			}
+		} else if thumbprint != "" || certificateVariable != "" {
+			return fmt.Errorf("binding %d: thumbprint and certificate_variable are only supported on https bindings", i)
+		}
+
+		if binding["require_sni"].(bool) {
+			if protocol != "https" {
+				return fmt.Errorf("binding %d: require_sni is only supported on https bindings", i)
+			}
+			if host == "" {
+				return fmt.Errorf("binding %d: require_sni requires a host", i)
+			}
+		}
+	}
+
+	return nil
 }
 
 func resourceDeploymentStepIisWebsiteCreate(d *schema.ResourceData, m interface{}) error {
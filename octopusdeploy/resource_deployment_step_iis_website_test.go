@@ -0,0 +1,111 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOctopusDeployDeploymentStepIisWebsiteInvalidBinding(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeploymentStepIisWebsiteBinding(`thumbprint = "0123456789ABCDEF"` + "\n" + `certificate_variable = "MyCertificate"`),
+				ExpectError: regexp.MustCompile("only one of thumbprint and certificate_variable can be set"),
+			},
+			{
+				Config:      testAccDeploymentStepIisWebsiteBinding(`protocol = "http"` + "\n" + `require_sni = true`),
+				ExpectError: regexp.MustCompile("require_sni is only supported on https bindings"),
+			},
+			{
+				Config:      testAccDeploymentStepIisWebsiteBinding(`protocol = "http"` + "\n" + `host = "not a host"`),
+				ExpectError: regexp.MustCompile("is not a valid host name"),
+			},
+		},
+	})
+}
+
+func TestFlattenConfiguredIisBindings(t *testing.T) {
+	defaultBindings := buildIisBindingsProperty(nil)
+
+	bindings, err := flattenConfiguredIisBindings(defaultBindings, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(bindings) != 0 {
+		t.Errorf("expected the default binding to read back as no bindings, got %v", bindings)
+	}
+
+	tfBindings := []interface{}{
+		map[string]interface{}{
+			"protocol":             "http",
+			"ip":                   "*",
+			"port":                 "80",
+			"host":                 "",
+			"thumbprint":           "",
+			"certificate_variable": "",
+			"require_sni":          false,
+			"enabled":              true,
+		},
+	}
+
+	bindings, err = flattenConfiguredIisBindings(buildIisBindingsProperty(tfBindings), tfBindings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(bindings, tfBindings) {
+		t.Errorf("expected a configured binding to read back as %v, got %v", tfBindings, bindings)
+	}
+}
+
+func TestAccOctopusDeployDeploymentStepIisWebsiteDeprecatedBinding(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// cert_var and enable are still accepted in place of certificate_variable and enabled
+				Config:             testAccDeploymentStepIisWebsiteBinding(`protocol = "https"` + "\n" + `cert_var = "MyCertificate"` + "\n" + `enable = false`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      testAccDeploymentStepIisWebsiteBinding(`protocol = "https"` + "\n" + `cert_var = "MyCertificate"` + "\n" + `certificate_variable = "MyCertificate"`),
+				ExpectError: regexp.MustCompile("only one of cert_var and certificate_variable can be set"),
+			},
+		},
+	})
+}
+
+func testAccDeploymentStepIisWebsiteBinding(binding string) string {
+	return fmt.Sprintf(`
+		resource "octopusdeploy_deployment_step_iis_website" "foo" {
+			project_id   = "Project-000"
+			step_name    = "Deploy Web Site"
+			feed_id      = "Feed-000"
+			package      = "website.yolo"
+			website_name = "Funky Site"
+
+			application_pool {
+				name = "Funky Pool"
+			}
+
+			target_roles = [
+				"MyRole1",
+			]
+
+			binding {
+				%s
+			}
+		}
+	`,
+		binding,
+	)
+}
@@ -59,13 +59,15 @@ func buildInlineScriptDeploymentStep(d *schema.ResourceData) *octopusdeploy.Depl
 	return deploymentStep
 }
 
-func setInlineScriptSchema(d *schema.ResourceData, deploymentStep octopusdeploy.DeploymentStep) {
+func setInlineScriptSchema(d *schema.ResourceData, deploymentStep octopusdeploy.DeploymentStep) error {
 	resourceDeploymentStep_SetBasicSchema(d, deploymentStep)
 
 	/* Get Script Properties */
 	d.Set("script_source", deploymentStep.Actions[0].Properties["Octopus.Action.Script.ScriptSource"])
 	d.Set("script_type", deploymentStep.Actions[0].Properties["Octopus.Action.Script.Syntax"])
 	d.Set("script_body", deploymentStep.Actions[0].Properties["Octopus.Action.Script.ScriptBody"])
+
+	return nil
 }
 
 func resourceDeploymentStepInlineScriptCreate(d *schema.ResourceData, m interface{}) error {
@@ -41,7 +41,7 @@ func resourceEnvironment() *schema.Resource {
 }
 
 func resourceEnvironmentRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	environmentID := d.Id()
 	env, err := client.Environment.Get(environmentID)
@@ -92,7 +92,7 @@ func buildEnvironmentResource(d *schema.ResourceData) *octopusdeploy.Environment
 }
 
 func resourceEnvironmentCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	newEnvironment := buildEnvironmentResource(d)
 	env, err := client.Environment.Add(newEnvironment)
@@ -110,7 +110,7 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, m interface{}) error {
 	env := buildEnvironmentResource(d)
 	env.ID = d.Id() // set project struct ID so octopus knows which project to update
 
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	updatedEnv, err := client.Environment.Update(env)
 
@@ -123,7 +123,7 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceEnvironmentDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	environmentID := d.Id()
 
@@ -54,7 +54,7 @@ func testEnvironmenttBasic(name, description, useguided string, dynamic string)
 
 func testOctopusDeployEnvironmentExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 		return existsEnvHelper(s, client)
 	}
 }
@@ -69,7 +69,7 @@ func existsEnvHelper(s *terraform.State, client *octopusdeploy.Client) error {
 }
 
 func testOctopusDeployEnvironmentDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	client := testAccProvider.Meta().(*providerMeta).Client
 	return destroyEnvHelper(s, client)
 }
 
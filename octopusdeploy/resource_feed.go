@@ -57,7 +57,7 @@ func resourceFeed() *schema.Resource {
 }
 
 func resourceFeedRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	feedId := d.Id()
 	feed, err := client.Feed.Get(feedId)
@@ -142,7 +142,7 @@ func buildFeedResource(d *schema.ResourceData) *octopusdeploy.Feed {
 }
 
 func resourceFeedCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	newFeed := buildFeedResource(d)
 	feed, err := client.Feed.Add(newFeed)
@@ -160,7 +160,7 @@ func resourceFeedUpdate(d *schema.ResourceData, m interface{}) error {
 	feed := buildFeedResource(d)
 	feed.ID = d.Id() // set project struct ID so octopus knows which project to update
 
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	updatedFeed, err := client.Feed.Update(feed)
 
@@ -173,7 +173,7 @@ func resourceFeedUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceFeedDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	feedId := d.Id()
 
@@ -0,0 +1,202 @@
+package octopusdeploy
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceGeneratedCertificate generates a self-signed certificate in the provider and uploads it to Octopus.
+// The certificate is uploaded as PEM, with the certificate and private key in the same file. Any change to the
+// subject, names, key size or validity generates a new certificate.
+func resourceGeneratedCertificate() *schema.Resource {
+	resource := &schema.Resource{
+		Create: resourceGeneratedCertificateCreate,
+		Read:   resourceCertificateRead,
+		Update: resourceGeneratedCertificateUpdate,
+		Delete: resourceCertificateDelete,
+
+		Schema: map[string]*schema.Schema{
+			"common_name": {
+				Type:        schema.TypeString,
+				Description: "The common name of the subject of the certificate",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"organization": {
+				Type:        schema.TypeString,
+				Description: "The organization of the subject of the certificate",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"dns_names": {
+				Type:        schema.TypeList,
+				Description: "DNS names added to the certificate as subject alternative names",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Description: "IP addresses added to the certificate as subject alternative names",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"key_size": {
+				Type:         schema.TypeInt,
+				Description:  "The size of the RSA key in bits",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      2048,
+				ValidateFunc: validateIntValueFunc([]int{2048, 3072, 4096}),
+			},
+			"validity_days": {
+				Type:         schema.TypeInt,
+				Description:  "The number of days the certificate is valid for",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      365,
+				ValidateFunc: validateIntAtLeastFunc(1),
+			},
+			"certificate_pem": {
+				Type:        schema.TypeString,
+				Description: "The generated certificate, without the private key, as PEM",
+				Computed:    true,
+			},
+		},
+	}
+
+	// The name, notes, scoping and computed details of the certificate are the same as octopusdeploy_certificate
+	for key, value := range resourceCertificate().Schema {
+		if key != "certificate_data" && key != "password" {
+			resource.Schema[key] = value
+		}
+	}
+
+	return resource
+}
+
+// generateSelfSignedCertificate returns the PEM encoded certificate, and the certificate followed by its
+// private key
+func generateSelfSignedCertificate(d *schema.ResourceData) (string, string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, d.Get("key_size").(int))
+	if err != nil {
+		return "", "", fmt.Errorf("error generating key: %s", err.Error())
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return "", "", fmt.Errorf("error generating serial number: %s", err.Error())
+	}
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return "", "", fmt.Errorf("error encoding public key: %s", err.Error())
+	}
+	subjectKeyID := sha1.Sum(publicKey)
+
+	notBefore := time.Now().UTC()
+
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName: d.Get("common_name").(string),
+		},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.AddDate(0, 0, d.Get("validity_days").(int)),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		SubjectKeyId:          subjectKeyID[:],
+		DNSNames:              getSliceFromTerraformTypeList(d.Get("dns_names")),
+	}
+
+	if organization := d.Get("organization").(string); organization != "" {
+		template.Subject.Organization = []string{organization}
+	}
+
+	for _, address := range getSliceFromTerraformTypeList(d.Get("ip_addresses")) {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			return "", "", fmt.Errorf("%s is not a valid IP address", address)
+		}
+		template.IPAddresses = append(template.IPAddresses, ip)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return "", "", fmt.Errorf("error creating certificate: %s", err.Error())
+	}
+
+	privateKey, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", "", fmt.Errorf("error encoding private key: %s", err.Error())
+	}
+
+	certificatePem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPem := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKey}))
+
+	return certificatePem, certificatePem + keyPem, nil
+}
+
+func resourceGeneratedCertificateCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).Client
+
+	certificatePem, certificateData, err := generateSelfSignedCertificate(d)
+	if err != nil {
+		return err
+	}
+
+	newCertificate := octopusdeploy.NewCertificate(d.Get("name").(string), octopusdeploy.SensitiveValue{NewValue: base64.StdEncoding.EncodeToString([]byte(certificateData))}, octopusdeploy.SensitiveValue{})
+	if err := buildCertificateMetadata(d, newCertificate); err != nil {
+		return err
+	}
+
+	certificate, err := client.Certificate.Add(newCertificate)
+	if err != nil {
+		return fmt.Errorf("error creating certificate %s: %s", newCertificate.Name, err.Error())
+	}
+
+	d.SetId(certificate.ID)
+	d.Set("certificate_pem", certificatePem)
+
+	if err := updateCertificateArchived(d, m.(*providerMeta).API, true); err != nil {
+		return err
+	}
+
+	return resourceCertificateRead(d, m)
+}
+
+func resourceGeneratedCertificateUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta).API
+
+	if err := updateCertificateArchived(d, api, false); err != nil {
+		return err
+	}
+
+	if err := updateCertificateMetadata(d, m.(*providerMeta).Client); err != nil {
+		return err
+	}
+
+	if err := updateCertificateArchived(d, api, true); err != nil {
+		return err
+	}
+
+	return resourceCertificateRead(d, m)
+}
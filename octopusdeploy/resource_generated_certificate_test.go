@@ -0,0 +1,116 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployGeneratedCertificateBasic(t *testing.T) {
+	const certPrefix = "octopusdeploy_generated_certificate.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testOctopusDeployGeneratedCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGeneratedCertificateBasic("Generated certificate"),
+				Check: resource.ComposeTestCheckFunc(
+					testOctopusDeployGeneratedCertificateExists(certPrefix),
+					resource.TestCheckResourceAttr(
+						certPrefix, "subject_common_name", "test.example.com"),
+					resource.TestCheckResourceAttr(
+						certPrefix, "has_private_key", "true"),
+					resource.TestCheckResourceAttr(
+						certPrefix, "self_signed", "true"),
+					resource.TestCheckResourceAttrSet(
+						certPrefix, "thumbprint"),
+					resource.TestCheckResourceAttrSet(
+						certPrefix, "not_after"),
+					resource.TestCheckResourceAttrSet(
+						certPrefix, "certificate_pem"),
+				),
+			},
+			{
+				// Renaming the certificate updates it in place
+				Config: testGeneratedCertificateBasic("Renamed certificate"),
+				Check: resource.ComposeTestCheckFunc(
+					testOctopusDeployGeneratedCertificateExists(certPrefix),
+					resource.TestCheckResourceAttr(
+						certPrefix, "name", "Renamed certificate"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployGeneratedCertificateInvalidValidity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "octopusdeploy_generated_certificate" "foo" {
+						name          = "Expired certificate"
+						common_name   = "test.example.com"
+						validity_days = 0
+					}
+				`,
+				ExpectError: regexp.MustCompile("Must be at least 1"),
+			},
+		},
+	})
+}
+
+func testGeneratedCertificateBasic(name string) string {
+	return fmt.Sprintf(`
+		resource "octopusdeploy_generated_certificate" "foo" {
+			name          = "%s"
+			common_name   = "test.example.com"
+			dns_names     = ["test.example.com", "www.test.example.com"]
+			validity_days = 30
+		}
+		`,
+		name,
+	)
+}
+
+func testOctopusDeployGeneratedCertificateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		certId := s.RootModule().Resources[n].Primary.ID
+
+		if _, err := client.Certificate.Get(certId); err != nil {
+			return fmt.Errorf("Received an error retrieving certificate %s", err)
+		}
+
+		return nil
+	}
+}
+
+func testOctopusDeployGeneratedCertificateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).Client
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "octopusdeploy_generated_certificate" {
+			continue
+		}
+
+		if _, err := client.Certificate.Get(r.Primary.ID); err != nil {
+			if err == octopusdeploy.ErrItemNotFound {
+				continue
+			}
+			return fmt.Errorf("Received an error retrieving certificate %s", err)
+		}
+		return fmt.Errorf("Certificate still exists")
+	}
+
+	return nil
+}
@@ -44,7 +44,7 @@ func getTemplatesSchema() *schema.Schema {
 }
 
 func resourceLibraryVariableSetCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	newLibraryVariableSet := buildLibraryVariableSetResource(d)
 
@@ -92,7 +92,7 @@ func buildTemplateResource(tfTemplate map[string]interface{}) octopusdeploy.Acti
 }
 
 func resourceLibraryVariableSetRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	libraryVariableSetID := d.Id()
 
@@ -119,7 +119,7 @@ func resourceLibraryVariableSetUpdate(d *schema.ResourceData, m interface{}) err
 	libraryVariableSet := buildLibraryVariableSetResource(d)
 	libraryVariableSet.ID = d.Id() // set libraryVariableSet struct ID so octopus knows which libraryVariableSet to update
 
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	libraryVariableSet, err := client.LibraryVariableSet.Update(libraryVariableSet)
 
@@ -133,7 +133,7 @@ func resourceLibraryVariableSetUpdate(d *schema.ResourceData, m interface{}) err
 }
 
 func resourceLibraryVariableSetDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	libraryVariableSetID := d.Id()
 
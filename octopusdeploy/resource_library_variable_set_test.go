@@ -94,7 +94,7 @@ func testAccLibraryVariableSetWithDescription(name, description string) string {
 }
 
 func testAccCheckOctopusDeployLibraryVariableSetDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	client := testAccProvider.Meta().(*providerMeta).Client
 
 	if err := destroyHelperLibraryVariableSet(s, client); err != nil {
 		return err
@@ -107,7 +107,7 @@ func testAccCheckOctopusDeployLibraryVariableSetDestroy(s *terraform.State) erro
 
 func testAccCheckOctopusDeployLibraryVariableSetExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 		if err := existsHelperLibraryVariableSet(s, client); err != nil {
 			return err
 		}
@@ -14,6 +14,8 @@ func resourceLifecycle() *schema.Resource {
 		Update: resourceLifecycleUpdate,
 		Delete: resourceLifecycleDelete,
 
+		CustomizeDiff: resourceLifecycleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -23,13 +25,22 @@ func resourceLifecycle() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"release_retention_policy":  getRetentionPeriodSchema(),
-			"tentacle_retention_policy": getRetentionPeriodSchema(),
+			"release_retention_policy":  getLifecycleRetentionPeriodSchema(),
+			"tentacle_retention_policy": getLifecycleRetentionPeriodSchema(),
 			"phase":                     getPhasesSchema(),
 		},
 	}
 }
 
+// getLifecycleRetentionPeriodSchema returns the schema of the retention policies of the lifecycle. Octopus
+// always has a policy for the lifecycle, so the server default is kept when the block is omitted.
+func getLifecycleRetentionPeriodSchema() *schema.Schema {
+	retentionPeriodSchema := getRetentionPeriodSchema()
+	retentionPeriodSchema.Computed = true
+
+	return retentionPeriodSchema
+}
+
 func getRetentionPeriodSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -49,10 +60,16 @@ func getRetentionPeriodSchema() *schema.Schema {
 				},
 				"quantity_to_keep": {
 					Type:        schema.TypeInt,
-					Description: "The number of days/releases to keep. If 0 all are kept.",
+					Description: "The number of days/releases to keep. Must be 0 when should_keep_forever is set.",
 					Default:     0,
 					Optional:    true,
 				},
+				"should_keep_forever": {
+					Type:        schema.TypeBool,
+					Description: "Keep all releases/files. quantity_to_keep must be greater than 0 when this isn't set.",
+					Default:     false,
+					Optional:    true,
+				},
 			},
 		},
 	}
@@ -96,15 +113,15 @@ func getPhasesSchema() *schema.Schema {
 						Type: schema.TypeString,
 					},
 				},
-				//"release_retention_policy": getRetentionPeriodSchema(),
-				//"tentacle_retention_policy": getRetentionPeriodSchema(),
+				"release_retention_policy":  getRetentionPeriodSchema(),
+				"tentacle_retention_policy": getRetentionPeriodSchema(),
 			},
 		},
 	}
 }
 
 func resourceLifecycleCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	newLifecycle := buildLifecycleResource(d)
 
@@ -128,12 +145,12 @@ func buildLifecycleResource(d *schema.ResourceData) *octopusdeploy.Lifecycle {
 		lifecycle.Description = attr.(string)
 	}
 
-	releaseRetentionPolicy := getRetentionPeriod(d, "release_retention_policy")
+	releaseRetentionPolicy := buildRetentionPeriod(d.Get("release_retention_policy"))
 	if releaseRetentionPolicy != nil {
 		lifecycle.ReleaseRetentionPolicy = *releaseRetentionPolicy
 	}
 
-	tentacleRetentionPolicy := getRetentionPeriod(d, "tentacle_retention_policy")
+	tentacleRetentionPolicy := buildRetentionPeriod(d.Get("tentacle_retention_policy"))
 	if tentacleRetentionPolicy != nil {
 		lifecycle.TentacleRetentionPolicy = *tentacleRetentionPolicy
 	}
@@ -150,17 +167,76 @@ func buildLifecycleResource(d *schema.ResourceData) *octopusdeploy.Lifecycle {
 	return lifecycle
 }
 
-func getRetentionPeriod(d *schema.ResourceData, key string) *octopusdeploy.RetentionPeriod {
-	attr, ok := d.GetOk(key)
-	if ok {
-		tfRetentionSettings := attr.(*schema.Set)
-		if len(tfRetentionSettings.List()) == 1 {
-			tfRetentionItem := tfRetentionSettings.List()[0].(map[string]interface{})
-			retention := octopusdeploy.RetentionPeriod{
-				Unit:           octopusdeploy.RetentionUnit(tfRetentionItem["unit"].(string)),
-				QuantityToKeep: int32(tfRetentionItem["quantity_to_keep"].(int)),
+// buildRetentionPeriod returns the retention policy of a retention block, or nil if the block is not set
+func buildRetentionPeriod(tfRetention interface{}) *octopusdeploy.RetentionPeriod {
+	if tfRetention == nil {
+		return nil
+	}
+
+	tfRetentionSettings := tfRetention.(*schema.Set).List()
+	if len(tfRetentionSettings) != 1 || tfRetentionSettings[0] == nil {
+		return nil
+	}
+
+	tfRetentionItem := tfRetentionSettings[0].(map[string]interface{})
+	retention := octopusdeploy.RetentionPeriod{
+		Unit:              octopusdeploy.RetentionUnit(tfRetentionItem["unit"].(string)),
+		QuantityToKeep:    int32(tfRetentionItem["quantity_to_keep"].(int)),
+		ShouldKeepForever: tfRetentionItem["should_keep_forever"].(bool),
+	}
+
+	return &retention
+}
+
+// validateRetentionPeriod checks that keeping everything is requested with should_keep_forever, rather than
+// with a quantity_to_keep of 0 that Octopus also reads as keep forever
+func validateRetentionPeriod(tfRetention interface{}, path string) error {
+	retention := buildRetentionPeriod(tfRetention)
+	if retention == nil {
+		return nil
+	}
+
+	if retention.ShouldKeepForever && retention.QuantityToKeep != 0 {
+		return fmt.Errorf("%s: quantity_to_keep must be 0 when should_keep_forever is set", path)
+	}
+
+	if !retention.ShouldKeepForever && retention.QuantityToKeep <= 0 {
+		return fmt.Errorf("%s: quantity_to_keep must be greater than 0, or set should_keep_forever to keep everything", path)
+	}
+
+	return nil
+}
+
+func flattenRetentionPeriod(retention *octopusdeploy.RetentionPeriod) []interface{} {
+	if retention == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"unit":                string(retention.Unit),
+			"quantity_to_keep":    int(retention.QuantityToKeep),
+			"should_keep_forever": retention.ShouldKeepForever,
+		},
+	}
+}
+
+func resourceLifecycleCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	for _, key := range []string{"release_retention_policy", "tentacle_retention_policy"} {
+		if d.NewValueKnown(key) {
+			if err := validateRetentionPeriod(d.Get(key), key); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, tfPhase := range d.Get("phase").([]interface{}) {
+		tfPhaseMap := tfPhase.(map[string]interface{})
+
+		for _, key := range []string{"release_retention_policy", "tentacle_retention_policy"} {
+			if err := validateRetentionPeriod(tfPhaseMap[key], fmt.Sprintf("phase %d %s", i, key)); err != nil {
+				return err
 			}
-			return &retention
 		}
 	}
 
@@ -183,11 +259,32 @@ func buildPhaseResource(tfPhase map[string]interface{}) octopusdeploy.Phase {
 		phase.OptionalDeploymentTargets = []string{}
 	}
 
+	phase.ReleaseRetentionPolicy = buildRetentionPeriod(tfPhase["release_retention_policy"])
+	phase.TentacleRetentionPolicy = buildRetentionPeriod(tfPhase["tentacle_retention_policy"])
+
 	return phase
 }
 
+func flattenPhases(phases []octopusdeploy.Phase) []interface{} {
+	tfPhases := []interface{}{}
+
+	for _, phase := range phases {
+		tfPhases = append(tfPhases, map[string]interface{}{
+			"name":                                  phase.Name,
+			"minimum_environments_before_promotion": int(phase.MinimumEnvironmentsBeforePromotion),
+			"is_optional_phase":                     phase.IsOptionalPhase,
+			"automatic_deployment_targets":          phase.AutomaticDeploymentTargets,
+			"optional_deployment_targets":           phase.OptionalDeploymentTargets,
+			"release_retention_policy":              flattenRetentionPeriod(phase.ReleaseRetentionPolicy),
+			"tentacle_retention_policy":             flattenRetentionPeriod(phase.TentacleRetentionPolicy),
+		})
+	}
+
+	return tfPhases
+}
+
 func resourceLifecycleRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	lifecycleID := d.Id()
 
@@ -206,6 +303,18 @@ func resourceLifecycleRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("name", lifecycle.Name)
 	d.Set("description", lifecycle.Description)
 
+	if err := d.Set("release_retention_policy", flattenRetentionPeriod(&lifecycle.ReleaseRetentionPolicy)); err != nil {
+		return fmt.Errorf("error setting release retention policy of lifecycle id %s: %s", lifecycleID, err.Error())
+	}
+
+	if err := d.Set("tentacle_retention_policy", flattenRetentionPeriod(&lifecycle.TentacleRetentionPolicy)); err != nil {
+		return fmt.Errorf("error setting tentacle retention policy of lifecycle id %s: %s", lifecycleID, err.Error())
+	}
+
+	if err := d.Set("phase", flattenPhases(lifecycle.Phases)); err != nil {
+		return fmt.Errorf("error setting phases of lifecycle id %s: %s", lifecycleID, err.Error())
+	}
+
 	return nil
 }
 
@@ -213,7 +322,7 @@ func resourceLifecycleUpdate(d *schema.ResourceData, m interface{}) error {
 	lifecycle := buildLifecycleResource(d)
 	lifecycle.ID = d.Id() // set lifecycle struct ID so octopus knows which lifecycle to update
 
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	lifecycle, err := client.Lifecycle.Update(lifecycle)
 
@@ -227,7 +336,7 @@ func resourceLifecycleUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceLifecycleDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	lifecycleID := d.Id()
 
@@ -2,6 +2,7 @@ package octopusdeploy
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
@@ -93,6 +94,52 @@ func TestAccOctopusDeployLifecycleComplex(t *testing.T) {
 	})
 }
 
+func TestAccOctopusDeployLifecyclePhaseRetention(t *testing.T) {
+	const terraformNamePrefix = "octopusdeploy_lifecycle.foo"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployLifecycleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePhaseRetention(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOctopusDeployLifecycleExists(terraformNamePrefix),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "release_retention_policy.#", "1"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "phase.#", "2"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "phase.0.name", "P1"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "phase.0.release_retention_policy.#", "1"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "phase.0.tentacle_retention_policy.#", "1"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "phase.1.release_retention_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployLifecycleInvalidRetention(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLifecycleRetention(`quantity_to_keep = 0`),
+				ExpectError: regexp.MustCompile("quantity_to_keep must be greater than 0"),
+			},
+			{
+				Config:      testAccLifecycleRetention(`should_keep_forever = true` + "\n" + `quantity_to_keep = 3`),
+				ExpectError: regexp.MustCompile("quantity_to_keep must be 0 when should_keep_forever is set"),
+			},
+		},
+	})
+}
+
 func testAccLifecycleBasic(name string) string {
 	return fmt.Sprintf(`
 		resource "octopusdeploy_lifecycle" "foo" {
@@ -156,8 +203,53 @@ func testAccLifecycleComplex() string {
 		`
 }
 
+func testAccLifecyclePhaseRetention() string {
+	return `
+		resource "octopusdeploy_lifecycle" "foo" {
+			name = "Funky Retention Lifecycle"
+
+			release_retention_policy {
+				unit                = "Days"
+				should_keep_forever = true
+			}
+
+			phase {
+				name = "P1"
+
+				release_retention_policy {
+					unit             = "Items"
+					quantity_to_keep = 3
+				}
+
+				tentacle_retention_policy {
+					unit             = "Days"
+					quantity_to_keep = 7
+				}
+			}
+
+			phase {
+				name = "P2"
+			}
+		}
+		`
+}
+
+func testAccLifecycleRetention(retention string) string {
+	return fmt.Sprintf(`
+		resource "octopusdeploy_lifecycle" "foo" {
+			name = "Funky Retention Lifecycle"
+
+			release_retention_policy {
+				%s
+			}
+		}
+		`,
+		retention,
+	)
+}
+
 func testAccCheckOctopusDeployLifecycleDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	client := testAccProvider.Meta().(*providerMeta).Client
 
 	if err := destroyHelperLifecycle(s, client); err != nil {
 		return err
@@ -170,7 +262,7 @@ func testAccCheckOctopusDeployLifecycleDestroy(s *terraform.State) error {
 
 func testAccCheckOctopusDeployLifecycleExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 		if err := existsHelperLifecycle(s, client); err != nil {
 			return err
 		}
@@ -180,7 +272,7 @@ func testAccCheckOctopusDeployLifecycleExists(n string) resource.TestCheckFunc {
 
 func testAccCheckOctopusDeployLifecyclePhaseCount(name string, expected int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 		lifecycle, err := client.Lifecycle.GetByName(name)
 
 		if err != nil {
@@ -174,7 +174,7 @@ func resourceMachine() *schema.Resource {
 }
 
 func resourceMachineRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	machineID := d.Id()
 	machine, err := client.Machine.Get(machineID)
@@ -286,7 +286,7 @@ func buildMachineResource(d *schema.ResourceData) *octopusdeploy.Machine {
 }
 
 func resourceMachineCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 	newMachine := buildMachineResource(d)
 	newMachine.Status = "Unknown" //We don't want TF to attempt to update a machine just because its status has changed, so set it to Unknown on creation and let TF sort it out in the future.
 	machine, err := client.Machine.Add(newMachine)
@@ -299,7 +299,7 @@ func resourceMachineCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceMachineDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 	machineID := d.Id()
 	err := client.Machine.Delete(machineID)
 	if err != nil {
@@ -312,7 +312,7 @@ func resourceMachineDelete(d *schema.ResourceData, m interface{}) error {
 func resourceMachineUpdate(d *schema.ResourceData, m interface{}) error {
 	machine := buildMachineResource(d)
 	machine.ID = d.Id() // set project struct ID so octopus knows which project to update
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 	updatedMachine, err := client.Machine.Update(machine)
 	if err != nil {
 		return fmt.Errorf("error updating machine id %s: %s", d.Id(), err.Error())
@@ -64,7 +64,7 @@ func testMachineBasic(machineName string) string {
 
 func testOctopusDeployMachineExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 		return existsMachineHelper(s, client)
 	}
 }
@@ -80,7 +80,7 @@ func existsMachineHelper(s *terraform.State, client *octopusdeploy.Client) error
 }
 
 func testOctopusDeployMachineDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	client := testAccProvider.Meta().(*providerMeta).Client
 	return destroyMachineHelper(s, client)
 }
 
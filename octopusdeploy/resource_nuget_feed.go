@@ -52,7 +52,7 @@ func resourceNugetFeed() *schema.Resource {
 }
 
 func resourceNugetFeedRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	feedId := d.Id()
 	feed, err := client.Feed.Get(feedId)
@@ -130,7 +130,7 @@ func buildNugetFeedResource(d *schema.ResourceData) *octopusdeploy.Feed {
 }
 
 func resourceNugetFeedCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	newFeed := buildNugetFeedResource(d)
 	feed, err := client.Feed.Add(newFeed)
@@ -148,7 +148,7 @@ func resourceNugetFeedUpdate(d *schema.ResourceData, m interface{}) error {
 	feed := buildNugetFeedResource(d)
 	feed.ID = d.Id() // set project struct ID so octopus knows which project to update
 
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	updatedFeed, err := client.Feed.Update(feed)
 
@@ -161,7 +161,7 @@ func resourceNugetFeedUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNugetFeedDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	feedId := d.Id()
 
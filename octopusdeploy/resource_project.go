@@ -17,6 +17,8 @@ func resourceProject() *schema.Resource {
 		Update: resourceProjectUpdate,
 		Delete: resourceProjectDelete,
 
+		CustomizeDiff: resourceProjectCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -429,6 +431,7 @@ func getDeploymentStepDeployPackageSchema() *schema.Schema {
 	schemaToReturn.Elem = addConfigurationTransformDeploymentStepSchema(schemaToReturn.Elem)
 	schemaToReturn.Elem = addFeedAndPackageDeploymentStepSchema(schemaToReturn.Elem)
 	schemaToReturn.Elem = addStandardDeploymentStepSchema(schemaToReturn.Elem, false)
+	addCustomDeploymentScriptsFeature(schemaToReturn.Elem.(*schema.Resource))
 
 	return schemaToReturn
 }
@@ -475,39 +478,31 @@ func getDeploymentStepIISWebsiteSchema() *schema.Schema {
 	return schemaToReturn
 }
 
-// getDeploymentStepWindowsServiceSchema returns schema for a Windows Service deployment step
+// getDeploymentStepWindowsServiceSchema returns schema for a Windows Service deployment step. The service settings
+// are the ones of deploy_windows_service_action, with the start mode kept as service_start_mode.
 func getDeploymentStepWindowsServiceSchema() *schema.Schema {
+	element := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+	}
+	addDeployWindowsServiceSchema(element)
+
+	delete(element.Schema, "start_mode")
+	element.Schema["service_start_mode"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "auto",
+		ValidateFunc: validateValueFunc([]string{
+			"auto",
+			"delayed-auto",
+			"demand",
+			"unchanged",
+		}),
+	}
+
 	schemaToReturn := &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"executable_path": {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-				"service_account": {
-					Type:     schema.TypeString,
-					Optional: true,
-					Default:  "LocalSystem",
-				},
-				"service_name": {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-				"service_start_mode": {
-					Type:     schema.TypeString,
-					Optional: true,
-					Default:  "auto",
-					ValidateFunc: validateValueFunc([]string{
-						"auto",
-						"delayed-auto",
-						"demand",
-						"unchanged",
-					}),
-				},
-			},
-		},
+		Elem:     element,
 	}
 
 	schemaToReturn.Elem = addFeedAndPackageDeploymentStepSchema(schemaToReturn.Elem)
@@ -517,6 +512,39 @@ func getDeploymentStepWindowsServiceSchema() *schema.Schema {
 	return schemaToReturn
 }
 
+// getProjectWindowsService returns the service settings of a windows_service block, keyed as the
+// deploy_windows_service_action ones
+func getProjectWindowsService(localStep map[string]interface{}) map[string]interface{} {
+	tfService := map[string]interface{}{}
+	for key, value := range localStep {
+		tfService[key] = value
+	}
+	tfService["start_mode"] = localStep["service_start_mode"]
+
+	return tfService
+}
+
+// resourceProjectCustomizeDiff checks the service settings of the windows_service deployment steps
+func resourceProjectCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	for _, rawDeploymentStep := range d.Get("deployment_step").([]interface{}) {
+		deploymentStep, ok := rawDeploymentStep.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		steps, _ := deploymentStep["windows_service"].([]interface{})
+		for _, raw := range steps {
+			if localStep, ok := raw.(map[string]interface{}); ok {
+				if err := validateWindowsService(getProjectWindowsService(localStep)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func buildDeploymentProcess(d *schema.ResourceData, deploymentProcess *octopusdeploy.DeploymentProcess) *octopusdeploy.DeploymentProcess {
 	deploymentProcess.Steps = nil // empty the steps
 
@@ -534,14 +562,10 @@ func buildDeploymentProcess(d *schema.ResourceData, deploymentProcess *octopusde
 
 					configurationTransforms := localStep["configuration_transforms"].(bool)
 					configurationVariables := localStep["configuration_variables"].(bool)
-					executablePath := localStep["executable_path"].(string)
 					feedID := localStep["feed_id"].(string)
 					jsonFileVariableReplacement := localStep["json_file_variable_replacement"].(string)
 					variableSubstitutionInFiles := localStep["variable_substitution_in_files"].(string)
 					packageID := localStep["package"].(string)
-					serviceAccount := localStep["service_account"].(string)
-					serviceName := localStep["service_name"].(string)
-					serviceStartMode := localStep["service_start_mode"].(string)
 					stepCondition := localStep["step_condition"].(string)
 					stepName := localStep["step_name"].(string)
 					stepStartTrigger := localStep["step_start_trigger"].(string)
@@ -556,34 +580,31 @@ func buildDeploymentProcess(d *schema.ResourceData, deploymentProcess *octopusde
 								Name:       stepName,
 								ActionType: "Octopus.WindowsService",
 								Properties: map[string]string{
-									"Octopus.Action.WindowsService.CreateOrUpdateService":                       "True",
-									"Octopus.Action.WindowsService.ServiceAccount":                              serviceAccount,
-									"Octopus.Action.WindowsService.StartMode":                                   serviceStartMode,
 									"Octopus.Action.Package.AutomaticallyRunConfigurationTransformationFiles":   strconv.FormatBool(configurationTransforms),
 									"Octopus.Action.Package.AutomaticallyUpdateAppSettingsAndConnectionStrings": strconv.FormatBool(configurationVariables),
-									"Octopus.Action.EnabledFeatures":                                            "Octopus.Features.WindowsService,Octopus.Features.ConfigurationTransforms,Octopus.Features.ConfigurationVariables",
 									"Octopus.Action.Package.FeedId":                                             feedID,
 									"Octopus.Action.Package.PackageId":                                          packageID,
 									"Octopus.Action.Package.DownloadOnTentacle":                                 "False",
-									"Octopus.Action.WindowsService.ServiceName":                                 serviceName,
-									"Octopus.Action.WindowsService.ExecutablePath":                              executablePath,
 								},
 							},
 						},
 					}
 
+					addWindowsServiceToActionResource(getProjectWindowsService(localStep), deploymentStep.Actions[0])
+					enableFeatures(deploymentStep.Actions[0].Properties, featureConfigurationTransforms, featureConfigurationVariables)
+
 					if jsonFileVariableReplacement != "" {
 						deploymentStep.Actions[0].Properties["Octopus.Action.Package.JsonConfigurationVariablesTargets"] = jsonFileVariableReplacement
 						deploymentStep.Actions[0].Properties["Octopus.Action.Package.JsonConfigurationVariablesEnabled"] = "True"
 
-						deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.JsonConfigurationVariables"
+						enableFeatures(deploymentStep.Actions[0].Properties, featureJsonConfigurationVariables)
 					}
 
 					if variableSubstitutionInFiles != "" {
 						deploymentStep.Actions[0].Properties["Octopus.Action.SubstituteInFiles.TargetFiles"] = variableSubstitutionInFiles
 						deploymentStep.Actions[0].Properties["Octopus.Action.SubstituteInFiles.Enabled"] = "True"
 
-						deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.SubstituteInFiles"
+						enableFeatures(deploymentStep.Actions[0].Properties, featureSubstituteInFiles)
 					}
 
 					if targetRolesInterface, ok := localStep["target_roles"]; ok {
@@ -647,7 +668,6 @@ func buildDeploymentProcess(d *schema.ResourceData, deploymentProcess *octopusde
 									"Octopus.Action.IISWebSite.WebApplication.ApplicationPoolIdentityType":      applicationPoolIdentity,
 									"Octopus.Action.Package.AutomaticallyRunConfigurationTransformationFiles":   strconv.FormatBool(configurationTransforms),
 									"Octopus.Action.Package.AutomaticallyUpdateAppSettingsAndConnectionStrings": strconv.FormatBool(configurationVariables),
-									"Octopus.Action.EnabledFeatures":                                            "Octopus.Features.IISWebSite,Octopus.Features.ConfigurationTransforms,Octopus.Features.ConfigurationVariables",
 									"Octopus.Action.Package.FeedId":                                             feedID,
 									"Octopus.Action.Package.DownloadOnTentacle":                                 "False",
 									"Octopus.Action.IISWebSite.WebRootType":                                     "packageRoot",
@@ -661,18 +681,20 @@ func buildDeploymentProcess(d *schema.ResourceData, deploymentProcess *octopusde
 						},
 					}
 
+					enableFeatures(deploymentStep.Actions[0].Properties, featureIISWebSite, featureConfigurationTransforms, featureConfigurationVariables)
+
 					if jsonFileVariableReplacement != "" {
 						deploymentStep.Actions[0].Properties["Octopus.Action.Package.JsonConfigurationVariablesTargets"] = jsonFileVariableReplacement
 						deploymentStep.Actions[0].Properties["Octopus.Action.Package.JsonConfigurationVariablesEnabled"] = "True"
 
-						deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.JsonConfigurationVariables"
+						enableFeatures(deploymentStep.Actions[0].Properties, featureJsonConfigurationVariables)
 					}
 
 					if variableSubstitutionInFiles != "" {
 						deploymentStep.Actions[0].Properties["Octopus.Action.SubstituteInFiles.TargetFiles"] = variableSubstitutionInFiles
 						deploymentStep.Actions[0].Properties["Octopus.Action.SubstituteInFiles.Enabled"] = "True"
 
-						deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.SubstituteInFiles"
+						enableFeatures(deploymentStep.Actions[0].Properties, featureSubstituteInFiles)
 					}
 
 					if targetRolesInterface, ok := localStep["target_roles"]; ok {
@@ -887,24 +909,24 @@ func buildDeploymentProcess(d *schema.ResourceData, deploymentProcess *octopusde
 						deploymentStep.Actions[0].Properties["Octopus.Action.Package.JsonConfigurationVariablesTargets"] = jsonFileVariableReplacement
 						deploymentStep.Actions[0].Properties["Octopus.Action.Package.JsonConfigurationVariablesEnabled"] = "True"
 
-						deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.JsonConfigurationVariables"
+						enableFeatures(deploymentStep.Actions[0].Properties, featureJsonConfigurationVariables)
 					}
 
 					if variableSubstitutionInFiles != "" {
 						deploymentStep.Actions[0].Properties["Octopus.Action.SubstituteInFiles.TargetFiles"] = variableSubstitutionInFiles
 						deploymentStep.Actions[0].Properties["Octopus.Action.SubstituteInFiles.Enabled"] = "True"
 
-						deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.SubstituteInFiles"
+						enableFeatures(deploymentStep.Actions[0].Properties, featureSubstituteInFiles)
 					}
 
 					if configurationTransforms {
 						deploymentStep.Actions[0].Properties["Octopus.Action.Package.AutomaticallyRunConfigurationTransformationFiles"] = strconv.FormatBool(configurationTransforms)
-						deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.ConfigurationTransforms"
+						enableFeatures(deploymentStep.Actions[0].Properties, featureConfigurationTransforms)
 					}
 
 					if configurationVariables {
 						deploymentStep.Actions[0].Properties["Octopus.Action.Package.AutomaticallyUpdateAppSettingsAndConnectionStrings"] = strconv.FormatBool(configurationVariables)
-						deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.ConfigurationVariables"
+						enableFeatures(deploymentStep.Actions[0].Properties, featureConfigurationVariables)
 					}
 
 					if targetRolesInterface, ok := localStep["target_roles"]; ok {
@@ -1029,26 +1051,28 @@ func buildDeploymentProcess(d *schema.ResourceData, deploymentProcess *octopusde
 						deploymentStep.Actions[0].Properties["Octopus.Action.Package.JsonConfigurationVariablesTargets"] = jsonFileVariableReplacement
 						deploymentStep.Actions[0].Properties["Octopus.Action.Package.JsonConfigurationVariablesEnabled"] = "True"
 
-						deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.JsonConfigurationVariables"
+						enableFeatures(deploymentStep.Actions[0].Properties, featureJsonConfigurationVariables)
 					}
 
 					if variableSubstitutionInFiles != "" {
 						deploymentStep.Actions[0].Properties["Octopus.Action.SubstituteInFiles.TargetFiles"] = variableSubstitutionInFiles
 						deploymentStep.Actions[0].Properties["Octopus.Action.SubstituteInFiles.Enabled"] = "True"
 
-						deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.SubstituteInFiles"
+						enableFeatures(deploymentStep.Actions[0].Properties, featureSubstituteInFiles)
 					}
 
 					if configurationTransforms {
 						deploymentStep.Actions[0].Properties["Octopus.Action.Package.AutomaticallyRunConfigurationTransformationFiles"] = strconv.FormatBool(configurationTransforms)
-						deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.ConfigurationTransforms"
+						enableFeatures(deploymentStep.Actions[0].Properties, featureConfigurationTransforms)
 					}
 
 					if configurationVariables {
 						deploymentStep.Actions[0].Properties["Octopus.Action.Package.AutomaticallyUpdateAppSettingsAndConnectionStrings"] = strconv.FormatBool(configurationVariables)
-						deploymentStep.Actions[0].Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.ConfigurationVariables"
+						enableFeatures(deploymentStep.Actions[0].Properties, featureConfigurationVariables)
 					}
 
+					addCustomScriptsToProperties(localStep["custom_scripts"], deploymentStep.Actions[0].Properties)
+
 					if targetRolesInterface, ok := localStep["target_roles"]; ok {
 						var targetRoleSlice []string
 
@@ -1136,7 +1160,7 @@ func updateDeploymentProcess(d *schema.ResourceData, client *octopusdeploy.Clien
 }
 
 func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	newProject := buildProjectResource(d)
 
@@ -1160,7 +1184,7 @@ func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceProjectRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	projectID := d.Id()
 
@@ -1191,7 +1215,7 @@ func resourceProjectUpdate(d *schema.ResourceData, m interface{}) error {
 	project := buildProjectResource(d)
 	project.ID = d.Id() // set project struct ID so octopus knows which project to update
 
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	project, err := client.Project.Update(project)
 
@@ -1213,7 +1237,7 @@ func resourceProjectUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceProjectDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	projectID := d.Id()
 
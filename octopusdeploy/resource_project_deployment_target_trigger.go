@@ -129,7 +129,7 @@ func buildProjectDeploymentTargetTriggerResource(d *schema.ResourceData) (*octop
 }
 
 func resourceProjectDeploymentTargetTriggerCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	deploymentTargetTrigger, err := buildProjectDeploymentTargetTriggerResource(d)
 
@@ -148,7 +148,7 @@ func resourceProjectDeploymentTargetTriggerCreate(d *schema.ResourceData, m inte
 }
 
 func resourceProjectDeploymentTargetTriggerRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	projectTriggerID := d.Id()
 
@@ -182,7 +182,7 @@ func resourceProjectDeploymentTargetTriggerUpdate(d *schema.ResourceData, m inte
 
 	deploymentTargetTrigger.ID = d.Id() // set deploymenttrigger struct ID so octopus knows which to update
 
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	updatedProjectTrigger, err := client.ProjectTrigger.Update(deploymentTargetTrigger)
 
@@ -195,7 +195,7 @@ func resourceProjectDeploymentTargetTriggerUpdate(d *schema.ResourceData, m inte
 }
 
 func resourceProjectDeploymentTargetTriggerDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	projectTriggerID := d.Id()
 
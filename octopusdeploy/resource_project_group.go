@@ -41,7 +41,7 @@ func buildProjectGroupResource(d *schema.ResourceData) *octopusdeploy.ProjectGro
 }
 
 func resourceProjectGroupCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	newProjectGroup := buildProjectGroupResource(d)
 
@@ -56,7 +56,7 @@ func resourceProjectGroupCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceProjectGroupRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	projectGroupID := d.Id()
 
@@ -81,7 +81,7 @@ func resourceProjectGroupUpdate(d *schema.ResourceData, m interface{}) error {
 	projectGroup := buildProjectGroupResource(d)
 	projectGroup.ID = d.Id() // set projectgroup struct ID so octopus knows which  to update
 
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	updatedProject, err := client.ProjectGroup.Update(projectGroup)
 
@@ -94,7 +94,7 @@ func resourceProjectGroupUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceProjectGroupDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	projectGroupID := d.Id()
 
@@ -94,7 +94,7 @@ func testAccProjectGroupWithDescription(name, description string) string {
 }
 
 func testAccCheckOctopusDeployProjectGroupDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	client := testAccProvider.Meta().(*providerMeta).Client
 
 	if err := destroyHelperProjectGroup(s, client); err != nil {
 		return err
@@ -104,7 +104,7 @@ func testAccCheckOctopusDeployProjectGroupDestroy(s *terraform.State) error {
 
 func testAccCheckOctopusDeployProjectGroupExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 		if err := existsHelperProjectGroup(s, client); err != nil {
 			return err
 		}
@@ -82,6 +82,74 @@ func TestAccOctopusDeployProjectWithDeploymentStepWindowsService(t *testing.T) {
 	})
 }
 
+func TestAccOctopusDeployProjectWithDeploymentStepWindowsServiceOptions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWithDeploymentStepWindowsServiceOptions(`service_account = "_CUSTOM"`),
+				ExpectError: regexp.MustCompile("custom_account_name is required when service_account is _CUSTOM"),
+			},
+			{
+				Config: testAccWithDeploymentStepWindowsServiceOptions(`
+					display_name            = "My Service"
+					description             = "Do stuff"
+					arguments               = "-arg"
+					service_account         = "_CUSTOM"
+					custom_account_name     = "User"
+					custom_account_password = "Password"
+					dependencies            = "OtherService"
+					desired_status          = "Stopped"
+					service_start_mode      = "demand"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOctopusDeployProjectWindowsServiceProperties("octopusdeploy_project.foo", map[string]string{
+						"Octopus.Action.WindowsService.CreateOrUpdateService": "True",
+						"Octopus.Action.WindowsService.ServiceName":           "MyService",
+						"Octopus.Action.WindowsService.DisplayName":           "My Service",
+						"Octopus.Action.WindowsService.Description":           "Do stuff",
+						"Octopus.Action.WindowsService.ExecutablePath":        "MyService.exe",
+						"Octopus.Action.WindowsService.Arguments":             "-arg",
+						"Octopus.Action.WindowsService.ServiceAccount":        "_CUSTOM",
+						"Octopus.Action.WindowsService.CustomAccountName":     "User",
+						"Octopus.Action.WindowsService.CustomAccountPassword": "Password",
+						"Octopus.Action.WindowsService.StartMode":             "demand",
+						"Octopus.Action.WindowsService.Dependencies":          "OtherService",
+						"Octopus.Action.WindowsService.DesiredStatus":         "Stopped",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOctopusDeployProjectWindowsServiceProperties(n string, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		process, err := client.DeploymentProcess.Get(rs.Primary.Attributes["deployment_process_id"])
+		if err != nil {
+			return err
+		}
+
+		properties := process.Steps[0].Actions[0].Properties
+		for key, value := range expected {
+			if properties[key] != value {
+				return fmt.Errorf("%s is incorrect: %s, expected: %s", key, properties[key], value)
+			}
+		}
+
+		return nil
+	}
+}
+
 func TestAccOctopusDeployProjectWithUpdate(t *testing.T) {
 	return
 
@@ -379,8 +447,35 @@ func testAccWithDeploymentStepWindowsService(name, lifeCycleID, serviceName, exe
 	)
 }
 
+func testAccWithDeploymentStepWindowsServiceOptions(options string) string {
+	return fmt.Sprintf(`
+		resource "octopusdeploy_project_group" "foo" {
+			name = "Integration Test Project Group"
+		}
+
+		resource "octopusdeploy_project" "foo" {
+			name             = "Funky Monkey"
+			lifecycle_id     = "Lifecycles-1"
+			project_group_id = "${octopusdeploy_project_group.foo.id}"
+
+			deployment_step {
+				windows_service {
+					executable_path = "MyService.exe"
+					service_name    = "MyService"
+					step_name       = "Deploy MyService"
+					package         = "MyPackage"
+					target_roles    = ["Lab1"]
+					%s
+				}
+			}
+		}
+		`,
+		options,
+	)
+}
+
 func testAccCheckOctopusDeployProjectDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	client := testAccProvider.Meta().(*providerMeta).Client
 
 	if err := destroyProjectHelper(s, client); err != nil {
 		return err
@@ -390,7 +485,7 @@ func testAccCheckOctopusDeployProjectDestroy(s *terraform.State) error {
 
 func testAccCheckOctopusDeployProjectExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 		if err := existsHelper(s, client); err != nil {
 			return err
 		}
@@ -0,0 +1,369 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceProjectVariables manages every variable of a project as a single resource. The whole
+// variable set is replaced with one update, so variables added outside of Terraform show up as drift.
+func resourceProjectVariables() *schema.Resource {
+	return getVariableSetResource("project_id")
+}
+
+// resourceLibraryVariableSetVariables is the library variable set equivalent of resourceProjectVariables
+func resourceLibraryVariableSetVariables() *schema.Resource {
+	return getVariableSetResource("library_variable_set_id")
+}
+
+func getVariableSetResource(ownerKey string) *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVariableSetCreate(ownerKey),
+		Read:   resourceVariableSetRead(ownerKey),
+		Update: resourceVariableSetUpdate(ownerKey),
+		Delete: resourceVariableSetDelete,
+
+		CustomizeDiff: resourceVariableSetCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			ownerKey: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"variable": getVariableSetVariableSchema(),
+		},
+	}
+}
+
+func getVariableSetVariableSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "String",
+					ValidateFunc: validateValueFunc(variableTypes),
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"sensitive_value": {
+					Type:      schema.TypeString,
+					Optional:  true,
+					Sensitive: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"is_sensitive": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"scope":  schemaVariableScope,
+				"prompt": getVariablePromptSchema(),
+			},
+		},
+	}
+}
+
+func buildVariableSetVariableResource(tfVariable map[string]interface{}) (octopusdeploy.Variable, error) {
+	name := tfVariable["name"].(string)
+	varType := tfVariable["type"].(string)
+	isSensitive := tfVariable["is_sensitive"].(bool)
+
+	if isSensitive && varType != "Sensitive" {
+		return octopusdeploy.Variable{}, fmt.Errorf("variable %s: when is_sensitive is set to true, type needs to be 'Sensitive'", name)
+	}
+
+	if !isSensitive && varType == "Sensitive" {
+		return octopusdeploy.Variable{}, fmt.Errorf("variable %s: when type is set to 'Sensitive', is_sensitive needs to be true", name)
+	}
+
+	value := tfVariable["value"].(string)
+	if isSensitive {
+		value = tfVariable["sensitive_value"].(string)
+	}
+
+	variable := octopusdeploy.NewVariable(name, varType, value, tfVariable["description"].(string), nil, isSensitive)
+	variable.ID = tfVariable["id"].(string)
+
+	if scope, ok := tfVariable["scope"]; ok {
+		variable.Scope = buildVariableScopeResource(scope)
+	}
+
+	if prompt, ok := tfVariable["prompt"]; ok {
+		variable.Prompt = buildVariablePromptResource(prompt)
+	}
+
+	return *variable, nil
+}
+
+// flattenVariableSetVariables orders the variables to match the IDs already in state, so that a refresh
+// doesn't reorder the list. Variables not in state (added outside of Terraform) are appended at the end.
+func flattenVariableSetVariables(variables []octopusdeploy.Variable, tfVariables []interface{}) []interface{} {
+	stateIndex := map[string]int{}
+	for i, tfVariable := range tfVariables {
+		if id := tfVariable.(map[string]interface{})["id"].(string); id != "" {
+			stateIndex[id] = i
+		}
+	}
+
+	ordered := make([]interface{}, len(tfVariables))
+	var unknown []interface{}
+
+	for _, variable := range variables {
+		flattened := map[string]interface{}{
+			"id":           variable.ID,
+			"name":         variable.Name,
+			"type":         variable.Type,
+			"description":  variable.Description,
+			"is_sensitive": variable.IsSensitive,
+			"scope":        flattenVariableScope(variable.Scope),
+			"prompt":       flattenVariablePrompt(variable.Prompt),
+		}
+
+		i, inState := stateIndex[variable.ID]
+
+		// Octopus never returns sensitive values, so keep the one from state
+		if variable.IsSensitive {
+			if inState {
+				flattened["sensitive_value"] = tfVariables[i].(map[string]interface{})["sensitive_value"]
+			}
+		} else {
+			flattened["value"] = variable.Value
+		}
+
+		if inState {
+			ordered[i] = flattened
+		} else {
+			unknown = append(unknown, flattened)
+		}
+	}
+
+	var result []interface{}
+	for _, flattened := range ordered {
+		if flattened != nil {
+			result = append(result, flattened)
+		}
+	}
+
+	return append(result, unknown...)
+}
+
+// getVariableSetVariableKey identifies a variable by its name and scope, which Octopus keeps unique within a
+// variable set
+func getVariableSetVariableKey(variable octopusdeploy.Variable) string {
+	scope := octopusdeploy.VariableScope{}
+	if variable.Scope != nil {
+		scope = *variable.Scope
+	}
+
+	key := []string{variable.Name}
+	for _, values := range [][]string{scope.Project, scope.Environment, scope.Machine, scope.Role, scope.TargetRole, scope.Action, scope.User, scope.Private, scope.Channel, scope.TenantTag, scope.Tenant} {
+		sorted := append([]string{}, values...)
+		sort.Strings(sorted)
+		key = append(key, strings.Join(sorted, ","))
+	}
+
+	return strings.Join(key, "|")
+}
+
+// matchVariableSetVariables returns the ID of the variable in variables with the same name and scope as each
+// of wanted, or an empty ID when there is none. Each variable in variables is matched at most once.
+func matchVariableSetVariables(wanted []octopusdeploy.Variable, variables []octopusdeploy.Variable) []string {
+	unmatched := map[string][]string{}
+	for _, variable := range variables {
+		key := getVariableSetVariableKey(variable)
+		unmatched[key] = append(unmatched[key], variable.ID)
+	}
+
+	ids := make([]string, len(wanted))
+	for i, variable := range wanted {
+		key := getVariableSetVariableKey(variable)
+		if matches := unmatched[key]; len(matches) > 0 {
+			ids[i] = matches[0]
+			unmatched[key] = matches[1:]
+		}
+	}
+
+	return ids
+}
+
+func resourceVariableSetCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	attr, ok := d.GetOk("variable")
+	if !ok {
+		return nil
+	}
+
+	for i, tfVariable := range attr.([]interface{}) {
+		if !d.NewValueKnown(fmt.Sprintf("variable.%d.value", i)) || !d.NewValueKnown(fmt.Sprintf("variable.%d.type", i)) {
+			continue
+		}
+
+		tfVariableMap := tfVariable.(map[string]interface{})
+		if err := validateVariableReference(m.(*providerMeta), tfVariableMap["type"].(string), tfVariableMap["value"].(string)); err != nil {
+			return fmt.Errorf("variable %s: %s", tfVariableMap["name"], err.Error())
+		}
+	}
+
+	return nil
+}
+
+func resourceVariableSetCreate(ownerKey string) schema.CreateFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		d.SetId(d.Get(ownerKey).(string))
+
+		if err := resourceVariableSetUpdate(ownerKey)(d, m); err != nil {
+			d.SetId("")
+			return err
+		}
+
+		return nil
+	}
+}
+
+func resourceVariableSetRead(ownerKey string) schema.ReadFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		client := m.(*providerMeta).Client
+
+		ownerID := d.Id()
+
+		variables, err := client.Variable.GetAll(ownerID)
+
+		if err == octopusdeploy.ErrItemNotFound {
+			d.SetId("")
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading variables of %s: %s", ownerID, err.Error())
+		}
+
+		var tfVariables []interface{}
+		if attr, ok := d.GetOk("variable"); ok {
+			tfVariables = attr.([]interface{})
+		}
+
+		d.Set(ownerKey, ownerID)
+
+		if err := d.Set("variable", flattenVariableSetVariables(variables.Variables, tfVariables)); err != nil {
+			return fmt.Errorf("error setting variables of %s: %s", ownerID, err.Error())
+		}
+
+		return nil
+	}
+}
+
+func resourceVariableSetUpdate(ownerKey string) schema.UpdateFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		octoMutex.Lock("atom-variable")
+		defer octoMutex.Unlock("atom-variable")
+
+		client := m.(*providerMeta).Client
+
+		ownerID := d.Id()
+
+		current, err := client.Variable.GetAll(ownerID)
+		if err != nil {
+			return fmt.Errorf("error reading variables of %s: %s", ownerID, err.Error())
+		}
+
+		variables := []octopusdeploy.Variable{}
+		if attr, ok := d.GetOk("variable"); ok {
+			for _, tfVariable := range attr.([]interface{}) {
+				variable, err := buildVariableSetVariableResource(tfVariable.(map[string]interface{}))
+				if err != nil {
+					return err
+				}
+
+				variables = append(variables, variable)
+			}
+		}
+
+		// IDs in state follow the position of each variable in the list, so variables are matched to the ones
+		// on the server by name and scope instead
+		for i, id := range matchVariableSetVariables(variables, current.Variables) {
+			variables[i].ID = id
+		}
+
+		current.Variables = variables
+
+		log.Printf("[INFO] Replacing %d variables of %s", len(current.Variables), ownerID)
+		updated, err := client.Variable.Update(ownerID, current)
+
+		if err != nil {
+			return fmt.Errorf("error updating variables of %s: %s", ownerID, err.Error())
+		}
+
+		// The server assigns IDs to new variables
+		if attr, ok := d.GetOk("variable"); ok {
+			tfVariables := attr.([]interface{})
+			for i, id := range matchVariableSetVariables(variables, updated.Variables) {
+				if id == "" {
+					return fmt.Errorf("unable to match variable %s of %s to the server response", variables[i].Name, ownerID)
+				}
+
+				tfVariables[i].(map[string]interface{})["id"] = id
+			}
+
+			if err := d.Set("variable", tfVariables); err != nil {
+				return fmt.Errorf("error setting variables of %s: %s", ownerID, err.Error())
+			}
+		}
+
+		return resourceVariableSetRead(ownerKey)(d, m)
+	}
+}
+
+func resourceVariableSetDelete(d *schema.ResourceData, m interface{}) error {
+	octoMutex.Lock("atom-variable")
+	defer octoMutex.Unlock("atom-variable")
+
+	client := m.(*providerMeta).Client
+
+	ownerID := d.Id()
+
+	current, err := client.Variable.GetAll(ownerID)
+
+	if err == octopusdeploy.ErrItemNotFound {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading variables of %s: %s", ownerID, err.Error())
+	}
+
+	current.Variables = []octopusdeploy.Variable{}
+
+	if _, err := client.Variable.Update(ownerID, current); err != nil {
+		return fmt.Errorf("error deleting variables of %s: %s", ownerID, err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,142 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployProjectVariablesBasic(t *testing.T) {
+	const terraformNamePrefix = "octopusdeploy_project_variables.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testOctopusDeployProjectVariablesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectVariablesBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testOctopusDeployProjectVariablesCount(2),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "variable.#", "2"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "variable.0.name", "tf-var-1"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "variable.0.value", "abcd-123456"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "variable.1.name", "tf-var-1"),
+					resource.TestCheckResourceAttr(
+						terraformNamePrefix, "variable.1.scope.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						terraformNamePrefix, "variable.1.id"),
+				),
+			},
+		},
+	})
+}
+
+func testProjectVariablesBasic() string {
+	return `
+		resource "octopusdeploy_project_group" "foo" {
+			name = "Integration Test Project Group"
+		}
+
+		resource "octopusdeploy_project" "foo" {
+			name             = "Funky Monkey Variables Test"
+			lifecycle_id     = "Lifecycles-1"
+			project_group_id = "${octopusdeploy_project_group.foo.id}"
+		}
+
+		resource "octopusdeploy_project_variables" "foo" {
+			project_id = "${octopusdeploy_project.foo.id}"
+
+			variable {
+				name  = "tf-var-1"
+				value = "abcd-123456"
+			}
+
+			variable {
+				name  = "tf-var-1"
+				value = "abcd-123456"
+
+				scope {
+					roles = ["MyRole"]
+				}
+			}
+		}
+		`
+}
+
+func testOctopusDeployProjectVariablesCount(count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+		projID := s.RootModule().Resources["octopusdeploy_project.foo"].Primary.ID
+
+		variables, err := client.Variable.GetAll(projID)
+		if err != nil {
+			return fmt.Errorf("Received an error retrieving variables %s", err)
+		}
+
+		if len(variables.Variables) != count {
+			return fmt.Errorf("Expected %d variables but found %d", count, len(variables.Variables))
+		}
+
+		return nil
+	}
+}
+
+func testOctopusDeployProjectVariablesDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).Client
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "octopusdeploy_project_variables" {
+			continue
+		}
+
+		variables, err := client.Variable.GetAll(r.Primary.ID)
+		if err != nil {
+			if err == octopusdeploy.ErrItemNotFound {
+				continue
+			}
+			return fmt.Errorf("Received an error retrieving variables %s", err)
+		}
+
+		if len(variables.Variables) > 0 {
+			return fmt.Errorf("Variables still exist")
+		}
+	}
+
+	return nil
+}
+
+func TestMatchVariableSetVariables(t *testing.T) {
+	scoped := func(id, name string, roles ...string) octopusdeploy.Variable {
+		return octopusdeploy.Variable{ID: id, Name: name, Scope: &octopusdeploy.VariableScope{Role: roles}}
+	}
+
+	current := []octopusdeploy.Variable{
+		scoped("Variables-1", "tf-var-1"),
+		scoped("Variables-2", "tf-var-1", "MyRole", "OtherRole"),
+		scoped("Variables-3", "tf-var-2"),
+	}
+
+	// A variable inserted before the others, and the scoped variable with its roles in another order
+	wanted := []octopusdeploy.Variable{
+		scoped("", "tf-var-0"),
+		scoped("", "tf-var-1", "OtherRole", "MyRole"),
+		scoped("", "tf-var-1"),
+		scoped("", "tf-var-2", "MyRole"),
+	}
+
+	expected := []string{"", "Variables-2", "Variables-1", ""}
+	ids := matchVariableSetVariables(wanted, current)
+	for i := range expected {
+		if ids[i] != expected[i] {
+			t.Errorf("variable %d matched %q, expected %q", i, ids[i], expected[i])
+		}
+	}
+}
@@ -0,0 +1,263 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// defaultRunbookRunsToKeep is the number of runs Octopus keeps in each environment for a new runbook
+const defaultRunbookRunsToKeep = 100
+
+func resourceRunbook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRunbookCreate,
+		Read:   resourceRunbookRead,
+		Update: resourceRunbookUpdate,
+		Delete: resourceRunbookDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceRunbookCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Description: "The project the runbook belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"runbook_process_id": {
+				Type:        schema.TypeString,
+				Description: "The process of the runbook, managed with octopusdeploy_runbook_process",
+				Computed:    true,
+			},
+			"multi_tenancy_mode": getTenantedDeploymentSchema(),
+			"environment_scope": {
+				Type:        schema.TypeString,
+				Description: "The environments the runbook can run in. Specified limits them to environment_ids",
+				Optional:    true,
+				Default:     "All",
+				ValidateFunc: validateValueFunc([]string{
+					"All",
+					"Specified",
+					"FromProjectLifecycles",
+				}),
+			},
+			"environment_ids": {
+				Type:        schema.TypeList,
+				Description: "The environments the runbook can run in, when environment_scope is Specified",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"default_failure_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "EnvironmentDefault",
+				ValidateFunc: validateValueFunc([]string{
+					"EnvironmentDefault",
+					"Off",
+					"On",
+				}),
+			},
+			"skip_machine_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "None",
+				ValidateFunc: validateValueFunc([]string{
+					"SkipUnavailableMachines",
+					"None",
+				}),
+			},
+			"allow_deployments_to_no_targets": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude_unhealthy_targets": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"run_retention_policy": {
+				Type:        schema.TypeList,
+				Description: "How many runs of the runbook are kept in each environment",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"quantity_to_keep": {
+							Type:         schema.TypeInt,
+							Description:  "The number of runs to keep",
+							Optional:     true,
+							Default:      defaultRunbookRunsToKeep,
+							ValidateFunc: validateIntAtLeastFunc(1),
+						},
+						"should_keep_forever": {
+							Type:        schema.TypeBool,
+							Description: "Whether every run is kept",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceRunbookCustomizeDiff checks environment_ids are only set when environment_scope is Specified, and
+// that they are then set
+func resourceRunbookCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("environment_scope") || !d.NewValueKnown("environment_ids") {
+		return nil
+	}
+
+	environmentScope := d.Get("environment_scope").(string)
+	environmentIDs := d.Get("environment_ids").([]interface{})
+
+	if environmentScope == "Specified" && len(environmentIDs) == 0 {
+		return fmt.Errorf("runbook %s: environment_ids is required when environment_scope is Specified", d.Get("name"))
+	}
+
+	if environmentScope != "Specified" && len(environmentIDs) > 0 {
+		return fmt.Errorf("runbook %s: environment_ids requires environment_scope to be Specified", d.Get("name"))
+	}
+
+	return nil
+}
+
+func buildRunbookResource(d *schema.ResourceData) *runbook {
+	environmentIDs := getSliceFromTerraformTypeList(d.Get("environment_ids"))
+	if environmentIDs == nil {
+		environmentIDs = []string{}
+	}
+
+	runbook := &runbook{
+		Name:                     d.Get("name").(string),
+		Description:              d.Get("description").(string),
+		ProjectID:                d.Get("project_id").(string),
+		MultiTenancyMode:         d.Get("multi_tenancy_mode").(string),
+		EnvironmentScope:         d.Get("environment_scope").(string),
+		Environments:             environmentIDs,
+		DefaultGuidedFailureMode: d.Get("default_failure_mode").(string),
+		ConnectivityPolicy: runbookConnectivityPolicy{
+			AllowDeploymentsToNoTargets: d.Get("allow_deployments_to_no_targets").(bool),
+			ExcludeUnhealthyTargets:     d.Get("exclude_unhealthy_targets").(bool),
+			SkipMachineBehavior:         d.Get("skip_machine_behavior").(string),
+			TargetRoles:                 []string{},
+		},
+		RunRetentionPolicy: runbookRunRetentionPolicy{
+			QuantityToKeep: defaultRunbookRunsToKeep,
+		},
+	}
+
+	if attr, ok := d.GetOk("run_retention_policy"); ok {
+		if tfPolicies := attr.([]interface{}); len(tfPolicies) > 0 && tfPolicies[0] != nil {
+			tfPolicy := tfPolicies[0].(map[string]interface{})
+			runbook.RunRetentionPolicy.QuantityToKeep = tfPolicy["quantity_to_keep"].(int)
+			runbook.RunRetentionPolicy.ShouldKeepForever = tfPolicy["should_keep_forever"].(bool)
+		}
+	}
+
+	return runbook
+}
+
+func resourceRunbookCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta).API
+
+	newRunbook := buildRunbookResource(d)
+
+	runbook, err := api.Runbook.Add(newRunbook)
+	if err != nil {
+		return fmt.Errorf("error creating runbook %s: %s", newRunbook.Name, err.Error())
+	}
+
+	d.SetId(runbook.ID)
+
+	return resourceRunbookRead(d, m)
+}
+
+func resourceRunbookRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta).API
+
+	runbookID := d.Id()
+
+	runbook, err := api.Runbook.Get(runbookID)
+
+	if err == octopusdeploy.ErrItemNotFound {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading runbook id %s: %s", runbookID, err.Error())
+	}
+
+	log.Printf("[DEBUG] runbook: %v", runbook)
+	d.Set("project_id", runbook.ProjectID)
+	d.Set("name", runbook.Name)
+	d.Set("description", runbook.Description)
+	d.Set("runbook_process_id", runbook.RunbookProcessID)
+	d.Set("multi_tenancy_mode", runbook.MultiTenancyMode)
+	d.Set("environment_scope", runbook.EnvironmentScope)
+	d.Set("environment_ids", runbook.Environments)
+	d.Set("default_failure_mode", runbook.DefaultGuidedFailureMode)
+	d.Set("skip_machine_behavior", runbook.ConnectivityPolicy.SkipMachineBehavior)
+	d.Set("allow_deployments_to_no_targets", runbook.ConnectivityPolicy.AllowDeploymentsToNoTargets)
+	d.Set("exclude_unhealthy_targets", runbook.ConnectivityPolicy.ExcludeUnhealthyTargets)
+
+	runRetentionPolicy := []interface{}{
+		map[string]interface{}{
+			"quantity_to_keep":    runbook.RunRetentionPolicy.QuantityToKeep,
+			"should_keep_forever": runbook.RunRetentionPolicy.ShouldKeepForever,
+		},
+	}
+
+	if err := d.Set("run_retention_policy", runRetentionPolicy); err != nil {
+		return fmt.Errorf("error setting run_retention_policy of runbook id %s: %s", runbookID, err.Error())
+	}
+
+	return nil
+}
+
+func resourceRunbookUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta).API
+
+	runbook := buildRunbookResource(d)
+	runbook.ID = d.Id() // set runbook struct ID so octopus knows which runbook to update
+	runbook.RunbookProcessID = d.Get("runbook_process_id").(string)
+
+	if _, err := api.Runbook.Update(runbook); err != nil {
+		return fmt.Errorf("error updating runbook id %s: %s", d.Id(), err.Error())
+	}
+
+	return resourceRunbookRead(d, m)
+}
+
+func resourceRunbookDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta).API
+
+	runbookID := d.Id()
+
+	if err := api.Runbook.Delete(runbookID); err != nil {
+		return fmt.Errorf("error deleting runbook id %s: %s", runbookID, err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,132 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceRunbookProcess manages the steps of a runbook. Octopus creates the process with the runbook, so
+// creating the resource sets the steps of that process, and destroying it removes them. The steps are the
+// same as those of octopusdeploy_deployment_process, and are checked in the same way.
+func resourceRunbookProcess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRunbookProcessCreate,
+		Read:   resourceRunbookProcessRead,
+		Update: resourceRunbookProcessUpdate,
+		Delete: resourceRunbookProcessDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceDeploymentProcessCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"runbook_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"step": getDeploymentStepSchema(),
+		},
+	}
+}
+
+func resourceRunbookProcessCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta).API
+
+	if err := validateDeploymentProcessTargetRoles(d); err != nil {
+		return err
+	}
+
+	runbookID := d.Get("runbook_id").(string)
+
+	runbook, err := api.Runbook.Get(runbookID)
+	if err != nil {
+		return fmt.Errorf("error getting runbook %s: %s", runbookID, err.Error())
+	}
+
+	d.SetId(runbook.RunbookProcessID)
+
+	if err := updateRunbookProcessSteps(d, api, buildDeploymentProcessSteps(d)); err != nil {
+		d.SetId("")
+		return fmt.Errorf("error creating runbook process: %s", err.Error())
+	}
+
+	return resourceRunbookProcessRead(d, m)
+}
+
+// updateRunbookProcessSteps replaces the steps of the runbook process of the resource
+func updateRunbookProcessSteps(d *schema.ResourceData, api *apiClient, steps []octopusdeploy.DeploymentStep) error {
+	current, err := api.RunbookProcess.Get(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if steps == nil {
+		steps = []octopusdeploy.DeploymentStep{}
+	}
+
+	current.Steps = steps
+	_, err = api.RunbookProcess.Update(current)
+
+	return err
+}
+
+func resourceRunbookProcessRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta).API
+
+	runbookProcessID := d.Id()
+
+	runbookProcess, err := api.RunbookProcess.Get(runbookProcessID)
+
+	if err == octopusdeploy.ErrItemNotFound {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading runbook process id %s: %s", runbookProcessID, err.Error())
+	}
+
+	log.Printf("[DEBUG] runbookProcess: %v", runbookProcess)
+	d.Set("runbook_id", runbookProcess.RunbookID)
+	d.Set("project_id", runbookProcess.ProjectID)
+
+	if err := setDeploymentProcessSteps(d, runbookProcess.Steps); err != nil {
+		return fmt.Errorf("error setting steps of runbook process id %s: %s", runbookProcessID, err.Error())
+	}
+
+	return nil
+}
+
+func resourceRunbookProcessUpdate(d *schema.ResourceData, m interface{}) error {
+	if err := validateDeploymentProcessTargetRoles(d); err != nil {
+		return err
+	}
+
+	if err := updateRunbookProcessSteps(d, m.(*providerMeta).API, buildDeploymentProcessSteps(d)); err != nil {
+		return fmt.Errorf("error updating runbook process id %s: %s", d.Id(), err.Error())
+	}
+
+	return resourceRunbookProcessRead(d, m)
+}
+
+// resourceRunbookProcessDelete removes the steps of the process. A process already deleted with its runbook is
+// left as it is.
+func resourceRunbookProcessDelete(d *schema.ResourceData, m interface{}) error {
+	err := updateRunbookProcessSteps(d, m.(*providerMeta).API, nil)
+
+	if err != nil && err != octopusdeploy.ErrItemNotFound {
+		return fmt.Errorf("error deleting runbook process id %s: %s", d.Id(), err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,285 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceRunbookScheduledTrigger() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRunbookScheduledTriggerCreate,
+		Read:   resourceRunbookScheduledTriggerRead,
+		Update: resourceRunbookScheduledTriggerUpdate,
+		Delete: resourceRunbookScheduledTriggerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceRunbookScheduledTriggerCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the trigger.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The project_id of the Project the runbook belongs to.",
+			},
+			"runbook_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The runbook the trigger runs.",
+			},
+			"environment_ids": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required:    true,
+				MinItems:    1,
+				Description: "The environments the runbook runs in.",
+			},
+			"tenant_ids": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "The tenants the runbook runs for.",
+			},
+			"tenant_tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "The tenant tags of the tenants the runbook runs for.",
+			},
+			"is_disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "UTC",
+				Description: "The timezone of the schedule, e.g. UTC or AUS Eastern Standard Time.",
+			},
+			"cron_expression": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"once_daily"},
+				Description:   "Runs the runbook on a cron schedule, e.g. 0 0 6 * * Mon-Fri.",
+			},
+			"once_daily": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"cron_expression"},
+				Description:   "Runs the runbook once a day, on the given days of the week.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validateRunbookTriggerStartTime,
+							DiffSuppressFunc: suppressEqualRunbookTriggerStartTime,
+							Description:      "When the runbook runs each day, as an RFC 3339 date and time, e.g. 2021-01-01T09:00:00Z. Only the time of day is used.",
+						},
+						"days_of_week": {
+							Type: schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validateValueFunc([]string{
+									"Monday",
+									"Tuesday",
+									"Wednesday",
+									"Thursday",
+									"Friday",
+									"Saturday",
+									"Sunday",
+								}),
+							},
+							Required: true,
+							MinItems: 1,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateRunbookTriggerStartTime(v interface{}, key string) (warns []string, errs []error) {
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q must be an RFC 3339 date and time, e.g. 2021-01-01T09:00:00Z, got: %s", key, v))
+	}
+
+	return
+}
+
+// suppressEqualRunbookTriggerStartTime ignores differences in how Octopus formats the same start time
+func suppressEqualRunbookTriggerStartTime(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
+
+// resourceRunbookScheduledTriggerCustomizeDiff checks the trigger has a schedule
+func resourceRunbookScheduledTriggerCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("cron_expression") {
+		return nil
+	}
+
+	if d.Get("cron_expression").(string) == "" && len(d.Get("once_daily").([]interface{})) == 0 {
+		return fmt.Errorf("runbook trigger %s: one of cron_expression and once_daily must be set", d.Get("name"))
+	}
+
+	return nil
+}
+
+func buildRunbookScheduledTriggerResource(d *schema.ResourceData) *runbookScheduledTrigger {
+	trigger := &runbookScheduledTrigger{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		ProjectID:   d.Get("project_id").(string),
+		IsDisabled:  d.Get("is_disabled").(bool),
+		Filter: runbookScheduledTriggerFilter{
+			Timezone: d.Get("timezone").(string),
+		},
+		Action: runbookScheduledTriggerAction{
+			ActionType:     "RunRunbook",
+			RunbookID:      d.Get("runbook_id").(string),
+			EnvironmentIDs: getSliceFromTerraformTypeList(d.Get("environment_ids")),
+			TenantIDs:      []string{},
+			TenantTags:     []string{},
+		},
+	}
+
+	if attr, ok := d.GetOk("tenant_ids"); ok {
+		trigger.Action.TenantIDs = getSliceFromTerraformTypeList(attr)
+	}
+
+	if attr, ok := d.GetOk("tenant_tags"); ok {
+		trigger.Action.TenantTags = getSliceFromTerraformTypeList(attr)
+	}
+
+	if attr, ok := d.GetOk("cron_expression"); ok {
+		trigger.Filter.FilterType = "CronExpressionSchedule"
+		trigger.Filter.CronExpression = attr.(string)
+	}
+
+	if attr, ok := d.GetOk("once_daily"); ok {
+		tfOnceDaily := attr.([]interface{})[0].(map[string]interface{})
+
+		trigger.Filter.FilterType = "OnceDailySchedule"
+		trigger.Filter.StartTime = tfOnceDaily["start_time"].(string)
+		trigger.Filter.DaysOfWeek = getSliceFromTerraformTypeList(tfOnceDaily["days_of_week"])
+	}
+
+	return trigger
+}
+
+func resourceRunbookScheduledTriggerCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta).API
+
+	trigger, err := api.RunbookTrigger.Add(buildRunbookScheduledTriggerResource(d))
+
+	if err != nil {
+		return fmt.Errorf("error creating runbook scheduled trigger: %s", err.Error())
+	}
+
+	d.SetId(trigger.ID)
+
+	return resourceRunbookScheduledTriggerRead(d, m)
+}
+
+func resourceRunbookScheduledTriggerRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta).API
+
+	triggerID := d.Id()
+
+	trigger, err := api.RunbookTrigger.Get(triggerID)
+
+	if err == octopusdeploy.ErrItemNotFound {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading runbook scheduled trigger id %s: %s", triggerID, err.Error())
+	}
+
+	log.Printf("[DEBUG] runbook scheduled trigger: %v", trigger)
+	d.Set("name", trigger.Name)
+	d.Set("description", trigger.Description)
+	d.Set("project_id", trigger.ProjectID)
+	d.Set("is_disabled", trigger.IsDisabled)
+	d.Set("runbook_id", trigger.Action.RunbookID)
+	d.Set("environment_ids", trigger.Action.EnvironmentIDs)
+	d.Set("tenant_ids", trigger.Action.TenantIDs)
+	d.Set("tenant_tags", trigger.Action.TenantTags)
+	d.Set("timezone", trigger.Filter.Timezone)
+	d.Set("cron_expression", trigger.Filter.CronExpression)
+
+	var onceDaily []interface{}
+	if trigger.Filter.FilterType == "OnceDailySchedule" {
+		onceDaily = append(onceDaily, map[string]interface{}{
+			"start_time":   trigger.Filter.StartTime,
+			"days_of_week": trigger.Filter.DaysOfWeek,
+		})
+	}
+
+	if err := d.Set("once_daily", onceDaily); err != nil {
+		return fmt.Errorf("error setting once_daily of runbook scheduled trigger id %s: %s", triggerID, err.Error())
+	}
+
+	return nil
+}
+
+func resourceRunbookScheduledTriggerUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta).API
+
+	trigger := buildRunbookScheduledTriggerResource(d)
+	trigger.ID = d.Id() // set trigger struct ID so octopus knows which to update
+
+	if _, err := api.RunbookTrigger.Update(trigger); err != nil {
+		return fmt.Errorf("error updating runbook scheduled trigger id %s: %s", d.Id(), err.Error())
+	}
+
+	return resourceRunbookScheduledTriggerRead(d, m)
+}
+
+func resourceRunbookScheduledTriggerDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta).API
+
+	triggerID := d.Id()
+
+	if err := api.RunbookTrigger.Delete(triggerID); err != nil {
+		return fmt.Errorf("error deleting runbook scheduled trigger id %s: %s", triggerID, err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,310 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployRunbook(t *testing.T) {
+	const runbookPrefix = "octopusdeploy_runbook.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployRunbookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunbook(`
+					description = "Restarts the website"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOctopusDeployRunbookExists(runbookPrefix),
+					resource.TestCheckResourceAttr(runbookPrefix, "name", "Restart Website"),
+					resource.TestCheckResourceAttr(runbookPrefix, "description", "Restarts the website"),
+					resource.TestCheckResourceAttr(runbookPrefix, "environment_scope", "All"),
+					resource.TestCheckResourceAttr(runbookPrefix, "run_retention_policy.0.quantity_to_keep", "100"),
+					resource.TestCheckResourceAttrSet(runbookPrefix, "runbook_process_id"),
+				),
+			},
+			{
+				Config: testAccRunbook(`
+					environment_scope         = "Specified"
+					environment_ids           = ["${octopusdeploy_environment.test.id}"]
+					default_failure_mode      = "On"
+					skip_machine_behavior     = "SkipUnavailableMachines"
+					exclude_unhealthy_targets = true
+
+					run_retention_policy {
+						quantity_to_keep = 5
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOctopusDeployRunbookExists(runbookPrefix),
+					resource.TestCheckResourceAttr(runbookPrefix, "environment_scope", "Specified"),
+					resource.TestCheckResourceAttrPair(runbookPrefix, "environment_ids.0", "octopusdeploy_environment.test", "id"),
+					resource.TestCheckResourceAttr(runbookPrefix, "default_failure_mode", "On"),
+					resource.TestCheckResourceAttr(runbookPrefix, "skip_machine_behavior", "SkipUnavailableMachines"),
+					resource.TestCheckResourceAttr(runbookPrefix, "exclude_unhealthy_targets", "true"),
+					resource.TestCheckResourceAttr(runbookPrefix, "run_retention_policy.0.quantity_to_keep", "5"),
+				),
+			},
+			{
+				ResourceName:      runbookPrefix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployRunbookEnvironmentScope(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRunbook(`environment_scope = "Specified"`),
+				ExpectError: regexp.MustCompile("environment_ids is required when environment_scope is Specified"),
+			},
+			{
+				Config:      testAccRunbook(`environment_ids = ["${octopusdeploy_environment.test.id}"]`),
+				ExpectError: regexp.MustCompile("environment_ids requires environment_scope to be Specified"),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployRunbookProcess(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployRunbookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunbookProcess(`Write-Host 'Restarting'`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("octopusdeploy_runbook_process.test", "id", "octopusdeploy_runbook.test", "runbook_process_id"),
+					resource.TestCheckResourceAttrPair("octopusdeploy_runbook_process.test", "project_id", "octopusdeploy_project.test", "id"),
+					testAccCheckOctopusDeployRunbookProcessScript(`Write-Host 'Restarting'`),
+				),
+			},
+			{
+				Config: testAccRunbookProcess(`Write-Host 'Restarting again'`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOctopusDeployRunbookProcessScript(`Write-Host 'Restarting again'`),
+				),
+			},
+			{
+				// Steps are only read back into configured steps, so an imported process has none
+				ResourceName:            "octopusdeploy_runbook_process.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"step"},
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployRunbookScheduledTrigger(t *testing.T) {
+	const triggerPrefix = "octopusdeploy_runbook_scheduled_trigger.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployRunbookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunbookScheduledTrigger(`
+					once_daily {
+						start_time   = "2021-01-01T09:00:00Z"
+						days_of_week = ["Monday", "Friday"]
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(triggerPrefix, "runbook_id", "octopusdeploy_runbook.test", "id"),
+					resource.TestCheckResourceAttr(triggerPrefix, "once_daily.0.days_of_week.#", "2"),
+					resource.TestCheckResourceAttr(triggerPrefix, "cron_expression", ""),
+				),
+			},
+			{
+				Config: testAccRunbookScheduledTrigger(`cron_expression = "0 0 6 * * Mon-Fri"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(triggerPrefix, "cron_expression", "0 0 6 * * Mon-Fri"),
+					resource.TestCheckResourceAttr(triggerPrefix, "once_daily.#", "0"),
+				),
+			},
+			{
+				ResourceName:      triggerPrefix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccRunbookScheduledTrigger(""),
+				ExpectError: regexp.MustCompile("one of cron_expression and once_daily must be set"),
+			},
+		},
+	})
+}
+
+func TestSuppressEqualRunbookTriggerStartTime(t *testing.T) {
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{"2021-01-01T09:00:00Z", "2021-01-01T09:00:00Z", true},
+		{"2021-01-01T09:00:00.000+00:00", "2021-01-01T09:00:00Z", true},
+		{"2021-01-01T19:00:00+10:00", "2021-01-01T09:00:00Z", true},
+		{"2021-01-01T10:00:00Z", "2021-01-01T09:00:00Z", false},
+		{"", "2021-01-01T09:00:00Z", false},
+	}
+
+	for _, c := range cases {
+		if suppress := suppressEqualRunbookTriggerStartTime("start_time", c.old, c.new, nil); suppress != c.suppress {
+			t.Errorf("%q to %q: expected suppress to be %t, got %t", c.old, c.new, c.suppress, suppress)
+		}
+	}
+}
+
+func testAccRunbookProject() string {
+	return `
+		resource "octopusdeploy_lifecycle" "test" {
+			name = "Runbook Test Lifecycle"
+		}
+
+		resource "octopusdeploy_project_group" "test" {
+			name = "Runbook Test Group"
+		}
+
+		resource "octopusdeploy_project" "test" {
+			name             = "Runbook Test Project"
+			lifecycle_id     = "${octopusdeploy_lifecycle.test.id}"
+			project_group_id = "${octopusdeploy_project_group.test.id}"
+		}
+
+		resource "octopusdeploy_environment" "test" {
+			name = "Runbook Test Environment"
+		}
+		`
+}
+
+func testAccRunbook(options string) string {
+	return fmt.Sprintf(`
+		%s
+
+		resource "octopusdeploy_runbook" "test" {
+			project_id = "${octopusdeploy_project.test.id}"
+			name       = "Restart Website"
+			%s
+		}
+		`, testAccRunbookProject(), options)
+}
+
+func testAccRunbookProcess(scriptBody string) string {
+	return fmt.Sprintf(`
+		%s
+
+		resource "octopusdeploy_runbook_process" "test" {
+			runbook_id = "${octopusdeploy_runbook.test.id}"
+
+			step {
+				name = "Restart"
+
+				run_script_action {
+					name          = "Restart"
+					run_on_server = true
+					script_body   = "%s"
+				}
+			}
+		}
+		`, testAccRunbook(""), scriptBody)
+}
+
+func testAccRunbookScheduledTrigger(schedule string) string {
+	return fmt.Sprintf(`
+		%s
+
+		resource "octopusdeploy_runbook_scheduled_trigger" "test" {
+			name            = "Nightly Restart"
+			project_id      = "${octopusdeploy_project.test.id}"
+			runbook_id      = "${octopusdeploy_runbook.test.id}"
+			environment_ids = ["${octopusdeploy_environment.test.id}"]
+			%s
+		}
+		`, testAccRunbook(""), schedule)
+}
+
+func testAccCheckOctopusDeployRunbookExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		api := testAccProvider.Meta().(*providerMeta).API
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if _, err := api.Runbook.Get(rs.Primary.ID); err != nil {
+			return fmt.Errorf("Received an error retrieving runbook %s", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOctopusDeployRunbookProcessScript(expectedScriptBody string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		api := testAccProvider.Meta().(*providerMeta).API
+
+		process, err := api.RunbookProcess.Get(s.RootModule().Resources["octopusdeploy_runbook_process.test"].Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(process.Steps) != 1 {
+			return fmt.Errorf("Expected 1 step, got %d", len(process.Steps))
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.Script" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Octopus.Action.Script.ScriptBody"] != expectedScriptBody {
+			return fmt.Errorf("ScriptBody is incorrect: %s", action.Properties["Octopus.Action.Script.ScriptBody"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOctopusDeployRunbookDestroy(s *terraform.State) error {
+	api := testAccProvider.Meta().(*providerMeta).API
+
+	for _, r := range s.RootModule().Resources {
+		var err error
+
+		switch r.Type {
+		case "octopusdeploy_runbook":
+			_, err = api.Runbook.Get(r.Primary.ID)
+		case "octopusdeploy_runbook_scheduled_trigger":
+			_, err = api.RunbookTrigger.Get(r.Primary.ID)
+		default:
+			continue
+		}
+
+		if err == nil {
+			return fmt.Errorf("%s %s still exists", r.Type, r.Primary.ID)
+		}
+
+		if err != octopusdeploy.ErrItemNotFound {
+			return fmt.Errorf("Received an error retrieving %s %s: %s", r.Type, r.Primary.ID, err)
+		}
+	}
+
+	return testAccCheckOctopusDeployDeploymentProcessDestroy(s)
+}
@@ -45,7 +45,7 @@ func getTagSchema() *schema.Schema {
 }
 
 func resourceTagSetRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	tagSetId := d.Id()
 	tagSet, err := client.TagSet.Get(tagSetId)
@@ -91,7 +91,7 @@ func buildTagResource(tfTag map[string]interface{}) octopusdeploy.Tag {
 }
 
 func resourceTagSetCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	newTagSet := buildTagSetResource(d)
 	tagSet, err := client.TagSet.Add(newTagSet)
@@ -109,7 +109,7 @@ func resourceTagSetUpdate(d *schema.ResourceData, m interface{}) error {
 	tagSet := buildTagSetResource(d)
 	tagSet.ID = d.Id() // set project struct ID so octopus knows which project to update
 
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	updatedTagSet, err := client.TagSet.Update(tagSet)
 
@@ -122,7 +122,7 @@ func resourceTagSetUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceTagSetDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	tagSetId := d.Id()
 
@@ -64,7 +64,7 @@ func testTagSettBasic(name, tagName1 string, tagColor1 string, tagName2 string,
 
 func testOctopusDeployTagSetExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 		return existstagSetHelper(s, client)
 	}
 }
@@ -79,7 +79,7 @@ func existstagSetHelper(s *terraform.State, client *octopusdeploy.Client) error
 }
 
 func testOctopusDeployTagSetDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	client := testAccProvider.Meta().(*providerMeta).Client
 	return destroytagSetHelper(s, client)
 }
 
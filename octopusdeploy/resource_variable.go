@@ -2,7 +2,9 @@ package octopusdeploy
 
 import (
 	"fmt"
+	"log"
 	"strings"
+	"time"
 
 	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
 	"github.com/hashicorp/terraform/helper/encryption"
@@ -18,6 +20,7 @@ func resourceVariable() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceVariableImport,
 		},
+		CustomizeDiff: resourceVariableCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
@@ -28,15 +31,9 @@ func resourceVariable() *schema.Resource {
 				Required: true,
 			},
 			"type": {
-				Type:     schema.TypeString,
-				Required: true,
-				ValidateFunc: validateValueFunc([]string{
-					"String",
-					"Sensitive",
-					"Certificate",
-					"AmazonWebServicesAccount",
-					"AzureAccount",
-				}),
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateValueFunc(variableTypes),
 			},
 			"value": {
 				Type:          schema.TypeString,
@@ -59,32 +56,7 @@ func resourceVariable() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
-			"prompt": {
-				Type:     schema.TypeSet,
-				MaxItems: 1,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"label": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"control_type": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "SingleLineText",
-						},
-						"description": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"required": {
-							Type:     schema.TypeBool,
-							Optional: true,
-						},
-					},
-				},
-			},
+			"prompt": getVariablePromptSchema(),
 			"pgp_key": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -102,6 +74,131 @@ func resourceVariable() *schema.Resource {
 	}
 }
 
+// variableTypes are the types of variable that can be managed by the provider
+var variableTypes = []string{
+	"String",
+	"Sensitive",
+	"Certificate",
+	"AmazonWebServicesAccount",
+	"AzureAccount",
+	"GoogleCloudAccount",
+	"UsernamePasswordAccount",
+	"WorkerPool",
+}
+
+// variableAccountTypes maps the account variable types to the kinds of account they may reference
+var variableAccountTypes = map[string][]string{
+	"AmazonWebServicesAccount": {"AmazonWebServicesAccount", "AmazonWebServicesRoleAccount"},
+	"AzureAccount":             {"AzureSubscription", "AzureServicePrincipal"},
+	"GoogleCloudAccount":       {"GoogleCloudAccount"},
+	"UsernamePasswordAccount":  {"UsernamePassword"},
+}
+
+func getVariablePromptSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"label": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"control_type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "SingleLineText",
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"required": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// resourceVariableCustomizeDiff checks that certificate, account and worker pool variables reference an
+// existing item of the right kind, so a mistyped ID fails at plan time instead of during a deployment.
+func resourceVariableCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("value") || !d.NewValueKnown("type") {
+		return nil
+	}
+
+	return validateVariableReference(m.(*providerMeta), d.Get("type").(string), d.Get("value").(string))
+}
+
+// validateVariableReference checks the referenced ID for variable types that point at another item.
+// Accounts and worker pools are read with the provider's API client, as the client can't read Google Cloud
+// accounts or worker pools.
+func validateVariableReference(meta *providerMeta, varType, value string) error {
+	if value == "" {
+		return nil
+	}
+
+	client := meta.Client
+
+	if varType == "Certificate" {
+		certificate, err := client.Certificate.Get(value)
+		if err != nil {
+			if err == octopusdeploy.ErrItemNotFound {
+				return fmt.Errorf("certificate %s referenced by the variable does not exist", value)
+			}
+			return fmt.Errorf("error reading certificate %s: %s", value, err.Error())
+		}
+
+		// The SDK can't return warnings from a diff, so expiring certificates are logged, or fail the plan
+		if days := meta.Config.CertificateExpiryWarningDays; days > 0 {
+			if expires, err := certificateExpiresWithin(certificate, days, time.Now()); err == nil && expires {
+				if meta.Config.CertificateExpiryFailPlan {
+					return fmt.Errorf("certificate %s (%s) referenced by the variable expires on %s, within %d days", certificate.Name, value, certificate.NotAfter, days)
+				}
+				log.Printf("[WARN] certificate %s (%s) referenced by the variable expires on %s, within %d days", certificate.Name, value, certificate.NotAfter, days)
+			}
+		}
+		return nil
+	}
+
+	if varType == "WorkerPool" {
+		_, err := meta.API.WorkerPool.Get(value)
+		if err == octopusdeploy.ErrItemNotFound {
+			return fmt.Errorf("worker pool %s referenced by the variable does not exist", value)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading worker pool %s: %s", value, err.Error())
+		}
+		return nil
+	}
+
+	accountTypes, ok := variableAccountTypes[varType]
+	if !ok {
+		return nil
+	}
+
+	account, err := meta.API.Account.Get(value)
+	if err == octopusdeploy.ErrItemNotFound {
+		return fmt.Errorf("account %s referenced by the %s variable does not exist", value, varType)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading account %s: %s", value, err.Error())
+	}
+
+	for _, accountType := range accountTypes {
+		if account.AccountType == accountType {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("account %s is a %s account, which can't be used for a %s variable", value, account.AccountType, varType)
+}
+
 func resourceVariableImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importStrings := strings.Split(d.Id(), ":")
 	if len(importStrings) != 2 {
@@ -115,11 +212,10 @@ func resourceVariableImport(d *schema.ResourceData, m interface{}) ([]*schema.Re
 }
 
 func resourceVariableRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 
 	variableID := d.Id()
 	projectID := d.Get("project_id").(string)
-	isSensitive := d.Get("is_sensitive").(bool)
 	tfVar, err := client.Variable.GetByID(projectID, variableID)
 
 	if err == octopusdeploy.ErrItemNotFound || tfVar == nil {
@@ -133,12 +229,26 @@ func resourceVariableRead(d *schema.ResourceData, m interface{}) error {
 
 	d.Set("name", tfVar.Name)
 	d.Set("type", tfVar.Type)
-	if isSensitive {
+	d.Set("description", tfVar.Description)
+	d.Set("is_sensitive", tfVar.IsSensitive)
+
+	// Octopus never returns sensitive values, or a hash of them, so changes to them on the server can't be
+	// detected. The value in state is kept as long as the variable is still sensitive on the server. If it
+	// was made non-sensitive outside of Terraform, the plain value is read back and the sensitive one dropped.
+	if tfVar.IsSensitive {
 		d.Set("value", nil)
 	} else {
 		d.Set("value", tfVar.Value)
+		d.Set("sensitive_value", nil)
+	}
+
+	if err := d.Set("scope", flattenVariableScope(tfVar.Scope)); err != nil {
+		return fmt.Errorf("error setting scope of Variable %s: %s", variableID, err.Error())
+	}
+
+	if err := d.Set("prompt", flattenVariablePrompt(tfVar.Prompt)); err != nil {
+		return fmt.Errorf("error setting prompt of Variable %s: %s", variableID, err.Error())
 	}
-	d.Set("description", tfVar.Description)
 
 	return nil
 }
@@ -168,26 +278,47 @@ func buildVariableResource(d *schema.ResourceData) *octopusdeploy.Variable {
 
 	newVar := octopusdeploy.NewVariable(varName, varType, varValue, varDesc, varScopeInterface, varSensitive)
 
-	varPrompt, ok := d.GetOk("prompt")
-	if ok {
-		tfPromptSettings := varPrompt.(*schema.Set)
-		if len(tfPromptSettings.List()) == 1 {
-			tfPromptList := tfPromptSettings.List()[0].(map[string]interface{})
-			newPrompt := octopusdeploy.VariablePromptOptions{
-				Description: tfPromptList["description"].(string),
-				Label:       tfPromptList["label"].(string),
-				Required:    tfPromptList["required"].(bool),
-				DisplaySettings: octopusdeploy.VariablePromptDisplaySettings{
-					ControlType: tfPromptList["control_type"].(string),
-				},
-			}
-			newVar.Prompt = &newPrompt
-		}
+	if varPrompt, ok := d.GetOk("prompt"); ok {
+		newVar.Prompt = buildVariablePromptResource(varPrompt)
 	}
 
 	return newVar
 }
 
+// buildVariablePromptResource converts the value of a prompt block into OctopusDeploy VariablePromptOptions
+func buildVariablePromptResource(tfPrompt interface{}) *octopusdeploy.VariablePromptOptions {
+	tfPromptSettings := tfPrompt.(*schema.Set)
+	if len(tfPromptSettings.List()) != 1 {
+		return nil
+	}
+
+	tfPromptList := tfPromptSettings.List()[0].(map[string]interface{})
+	return &octopusdeploy.VariablePromptOptions{
+		Description: tfPromptList["description"].(string),
+		Label:       tfPromptList["label"].(string),
+		Required:    tfPromptList["required"].(bool),
+		DisplaySettings: octopusdeploy.VariablePromptDisplaySettings{
+			ControlType: tfPromptList["control_type"].(string),
+		},
+	}
+}
+
+// flattenVariablePrompt converts OctopusDeploy VariablePromptOptions into the value of a prompt block
+func flattenVariablePrompt(prompt *octopusdeploy.VariablePromptOptions) []interface{} {
+	if prompt == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"label":        prompt.Label,
+			"control_type": prompt.DisplaySettings.ControlType,
+			"description":  prompt.Description,
+			"required":     prompt.Required,
+		},
+	}
+}
+
 func encryptSensitiveValue(d *schema.ResourceData, ov *octopusdeploy.Variable) (isEncrypted bool, keyFingerprint, encryptedValue string, err error) {
 	if isSensitive := d.Get("is_sensitive").(bool); !isSensitive {
 		return isEncrypted, keyFingerprint, encryptedValue, nil
@@ -221,7 +352,7 @@ func resourceVariableCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 	projID := d.Get("project_id").(string)
 
 	newVariable := buildVariableResource(d)
@@ -267,7 +398,7 @@ func resourceVariableUpdate(d *schema.ResourceData, m interface{}) error {
 	tfVar := buildVariableResource(d)
 	tfVar.ID = d.Id() // set project struct ID so octopus knows which project to update
 
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 	projID := d.Get("project_id").(string)
 
 	updatedVars, err := client.Variable.UpdateSingle(projID, tfVar)
@@ -302,7 +433,7 @@ func resourceVariableDelete(d *schema.ResourceData, m interface{}) error {
 	octoMutex.Lock("atom-variable")
 	defer octoMutex.Unlock("atom-variable")
 
-	client := m.(*octopusdeploy.Client)
+	client := m.(*providerMeta).Client
 	projID := d.Get("project_id").(string)
 
 	variableID := d.Id()
@@ -2,6 +2,8 @@ package octopusdeploy
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
@@ -38,6 +40,88 @@ func TestAccOctopusDeployVariableBasic(t *testing.T) {
 	})
 }
 
+func TestAccOctopusDeployVariableMissingReference(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testVariableMissingReference("Certificate", "Certificates-999999"),
+				ExpectError: regexp.MustCompile("certificate Certificates-999999 referenced by the variable does not exist"),
+			},
+			{
+				Config:      testVariableMissingReference("WorkerPool", "WorkerPools-999999"),
+				ExpectError: regexp.MustCompile("worker pool WorkerPools-999999 referenced by the variable does not exist"),
+			},
+			{
+				Config:      testVariableMissingReference("GoogleCloudAccount", "Accounts-999999"),
+				ExpectError: regexp.MustCompile("account Accounts-999999 referenced by the GoogleCloudAccount variable does not exist"),
+			},
+		},
+	})
+}
+
+func TestVariableScopeAndPromptRoundTrip(t *testing.T) {
+	scope := &octopusdeploy.VariableScope{
+		Environment: []string{"Environments-1", "Environments-2"},
+		Machine:     []string{"Machines-1"},
+		Action:      []string{"Action-1"},
+		Role:        []string{"Web"},
+		Channel:     []string{"Channels-1"},
+		TenantTag:   []string{"Regions/North", "Regions/South"},
+	}
+
+	prompt := &octopusdeploy.VariablePromptOptions{
+		Label:       "Release notes",
+		Description: "The notes of the release",
+		Required:    true,
+		DisplaySettings: octopusdeploy.VariablePromptDisplaySettings{
+			ControlType: "MultiLineText",
+		},
+	}
+
+	d := resourceVariable().TestResourceData()
+
+	if err := d.Set("scope", flattenVariableScope(scope)); err != nil {
+		t.Fatalf("error setting scope: %s", err)
+	}
+
+	if err := d.Set("prompt", flattenVariablePrompt(prompt)); err != nil {
+		t.Fatalf("error setting prompt: %s", err)
+	}
+
+	if built := buildVariableScopeResource(d.Get("scope")); !reflect.DeepEqual(built, scope) {
+		t.Errorf("expected scope %+v, got %+v", scope, built)
+	}
+
+	if built := buildVariablePromptResource(d.Get("prompt")); !reflect.DeepEqual(built, prompt) {
+		t.Errorf("expected prompt %+v, got %+v", prompt, built)
+	}
+}
+
+func testVariableMissingReference(varType, value string) string {
+	return fmt.Sprintf(`
+		resource "octopusdeploy_project_group" "foo" {
+			name = "Integration Test Project Group"
+		}
+
+		resource "octopusdeploy_project" "foo" {
+			name             = "Missing Reference Var Test"
+			lifecycle_id     = "Lifecycles-1"
+			project_group_id = "${octopusdeploy_project_group.foo.id}"
+		}
+
+		resource "octopusdeploy_variable" "foovar" {
+			project_id = "${octopusdeploy_project.foo.id}"
+			name       = "tf-var-reference"
+			type       = "%s"
+			value      = "%s"
+		}
+		`,
+		varType, value,
+	)
+}
+
 func testVariableBasic(projectName, projectLifecycleID, name, description, value string) string {
 	config := fmt.Sprintf(`
 		resource "octopusdeploy_project_group" "foo" {
@@ -66,7 +150,7 @@ func testVariableBasic(projectName, projectLifecycleID, name, description, value
 
 func testOctopusDeployVariableExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 		return existsVarHelper(s, client)
 	}
 }
@@ -83,7 +167,7 @@ func existsVarHelper(s *terraform.State, client *octopusdeploy.Client) error {
 }
 
 func testOctopusDeployVariableDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*octopusdeploy.Client)
+	client := testAccProvider.Meta().(*providerMeta).Client
 	return destroyVarHelper(s, client)
 }
 
@@ -0,0 +1,38 @@
+package octopusdeploy
+
+import (
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func getRunAwsCliScriptActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addExecutionLocationSchema(element)
+	addWorkerPoolSchema(element)
+	addScriptSourceSchema(element)
+	addPackagesSchema(element, false)
+	addAwsAccountSchema(element)
+
+	return actionSchema
+}
+
+// validateRunAwsCliScriptAction checks both the script source and the credentials of the action
+func validateRunAwsCliScriptAction(tfAction map[string]interface{}) error {
+	if err := validateScriptSource(tfAction); err != nil {
+		return err
+	}
+
+	return validateAwsAccount(tfAction)
+}
+
+func buildRunAwsCliScriptActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.AwsRunScript"
+
+	resource.Properties = merge(resource.Properties, buildScriptSourceProperties(tfAction))
+	resource.Properties = merge(resource.Properties, buildAwsAccountProperties(tfAction))
+
+	return resource
+}
@@ -0,0 +1,65 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployRunAwsCliScriptAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunAwsCliScriptAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRunAwsCliScriptAction(),
+				),
+			},
+		},
+	})
+}
+
+func testAccRunAwsCliScriptAction() string {
+	return testAccBuildTestAction(`
+		run_aws_cli_script_action {
+			name = "List Buckets"
+			run_on_server = true
+			region = "ap-southeast-2"
+			aws_account_variable = "AWS Account"
+			syntax = "Bash"
+			script_body = "aws s3 ls"
+		}
+	`)
+}
+
+func testAccCheckRunAwsCliScriptAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.AwsRunScript" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Octopus.Action.Script.ScriptBody"] != "aws s3 ls" {
+			return fmt.Errorf("ScriptBody is incorrect: %s", action.Properties["Octopus.Action.Script.ScriptBody"])
+		}
+
+		if action.Properties["Octopus.Action.Aws.Region"] != "ap-southeast-2" {
+			return fmt.Errorf("Region is incorrect: %s", action.Properties["Octopus.Action.Aws.Region"])
+		}
+
+		return nil
+	}
+}
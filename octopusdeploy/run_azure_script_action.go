@@ -0,0 +1,29 @@
+package octopusdeploy
+
+import (
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func getRunAzureScriptActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addExecutionLocationSchema(element)
+	addWorkerPoolSchema(element)
+	addScriptSourceSchema(element)
+	addPackagesSchema(element, false)
+	addAzureAccountSchema(element)
+
+	return actionSchema
+}
+
+func buildRunAzureScriptActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.AzurePowerShell"
+
+	resource.Properties = merge(resource.Properties, buildScriptSourceProperties(tfAction))
+	resource.Properties = merge(resource.Properties, buildAzureAccountProperties(tfAction))
+
+	return resource
+}
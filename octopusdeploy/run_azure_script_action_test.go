@@ -0,0 +1,64 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployRunAzureScriptAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunAzureScriptAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRunAzureScriptAction(),
+				),
+			},
+		},
+	})
+}
+
+func testAccRunAzureScriptAction() string {
+	return testAccAzureAccount + testAccBuildTestAction(`
+		run_azure_script_action {
+			name = "List Resource Groups"
+			run_on_server = true
+			azure_account_id = "${octopusdeploy_account.azure.id}"
+			syntax = "Bash"
+			script_body = "az group list"
+		}
+	`)
+}
+
+func testAccCheckRunAzureScriptAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.AzurePowerShell" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Octopus.Action.Script.ScriptBody"] != "az group list" {
+			return fmt.Errorf("ScriptBody is incorrect: %s", action.Properties["Octopus.Action.Script.ScriptBody"])
+		}
+
+		if action.Properties["Octopus.Action.Azure.AccountId"] == "" {
+			return fmt.Errorf("AccountId is not set")
+		}
+
+		return nil
+	}
+}
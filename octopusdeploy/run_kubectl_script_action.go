@@ -9,7 +9,7 @@ func getRunRunKubectlScriptSchema() *schema.Schema {
 
 	actionSchema, element := getCommonDeploymentActionSchema()
 	addExecutionLocationSchema(element)
-	addScriptFromPackageSchema(element)
+	addScriptSourceSchema(element)
 	addPackagesSchema(element, false)
 
 	return actionSchema
@@ -20,7 +20,7 @@ func buildRunKubectlScriptActionResource(tfAction map[string]interface{}) octopu
 
 	resource.ActionType = "Octopus.KubernetesRunScript"
 
-	resource.Properties = merge(resource.Properties, buildScriptFromPackageProperties(tfAction))
+	resource.Properties = merge(resource.Properties, buildScriptSourceProperties(tfAction))
 
 	return resource
 }
@@ -37,12 +37,3 @@ func merge(map1 map[string]string, map2 map[string]string) map[string]string {
 
 	return result
 }
-func buildScriptFromPackageProperties(tfAction map[string]interface{}) map[string]string {
-
-	properties := make(map[string]string)
-	properties["Octopus.Action.Script.ScriptFileName"] = tfAction["script_file_name"].(string)
-	properties["Octopus.Action.Script.ScriptParameters"] = tfAction["script_parameters"].(string)
-	properties["Octopus.Action.Script.ScriptSource"] = "Package"
-
-	return properties
-}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -44,7 +43,7 @@ func testAccRunKubectlScriptAction() string {
 
 func testAccCheckRunKubectlScriptAction() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 
 		process, err := getDeploymentProcess(s, client)
 		if err != nil {
@@ -68,3 +67,54 @@ func testAccCheckRunKubectlScriptAction() resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestAccOctopusDeployRunKubectlScriptActionInline(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunKubectlScriptActionInline(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRunKubectlScriptActionInline(),
+				),
+			},
+		},
+	})
+}
+
+func testAccRunKubectlScriptActionInline() string {
+	return testAccBuildTestAction(`
+		run_kubectl_script_action {
+            name = "Run Script"
+            run_on_server = true
+
+			script_body = "kubectl get pods"
+			syntax = "Bash"
+        }
+	`)
+}
+
+func testAccCheckRunKubectlScriptActionInline() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.Properties["Octopus.Action.Script.ScriptSource"] != "Inline" {
+			return fmt.Errorf("ScriptSource is incorrect: %s", action.Properties["Octopus.Action.Script.ScriptSource"])
+		}
+
+		if action.Properties["Octopus.Action.Script.ScriptBody"] != "kubectl get pods" {
+			return fmt.Errorf("ScriptBody is incorrect: %s", action.Properties["Octopus.Action.Script.ScriptBody"])
+		}
+
+		return nil
+	}
+}
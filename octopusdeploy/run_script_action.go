@@ -1,15 +1,30 @@
 package octopusdeploy
 
 import (
+	"fmt"
+
 	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+const (
+	scriptSourceInline  = "Inline"
+	scriptSourcePackage = "Package"
+)
+
+var scriptSyntaxes = []string{
+	"PowerShell",
+	"Bash",
+	"CSharp",
+	"FSharp",
+	"Python",
+}
+
 func getRunScriptActionSchema() *schema.Schema {
 
 	actionSchema, element := getCommonDeploymentActionSchema()
 	addExecutionLocationSchema(element)
-	addScriptFromPackageSchema(element)
+	addScriptSourceSchema(element)
 	addPackagesSchema(element, false)
 
 	element.Schema["variable_substitution_in_files"] = &schema.Schema{
@@ -21,7 +36,29 @@ func getRunScriptActionSchema() *schema.Schema {
 	return actionSchema
 }
 
-func addScriptFromPackageSchema(element *schema.Resource) {
+func addScriptSourceSchema(element *schema.Resource) {
+
+	element.Schema["script_source"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Where the script comes from, either 'Inline' or 'Package'. Inferred from script_body or script_file_name when not set",
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validateValueFunc([]string{scriptSourceInline, scriptSourcePackage}),
+	}
+
+	element.Schema["script_body"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The inline script body",
+		Optional:    true,
+	}
+
+	element.Schema["syntax"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "The scripting language of the inline script",
+		Optional:     true,
+		Default:      "PowerShell",
+		ValidateFunc: validateValueFunc(scriptSyntaxes),
+	}
 
 	element.Schema["script_file_name"] = &schema.Schema{
 		Type:        schema.TypeString,
@@ -41,7 +78,7 @@ func buildRunScriptActionResource(tfAction map[string]interface{}) octopusdeploy
 
 	resource.ActionType = "Octopus.Script"
 
-	resource.Properties = merge(resource.Properties, buildRunScriptFromPackageActionResource(tfAction))
+	resource.Properties = merge(resource.Properties, buildScriptSourceProperties(tfAction))
 
 	variableSubstitutionInFiles := tfAction["variable_substitution_in_files"].(string)
 
@@ -49,19 +86,91 @@ func buildRunScriptActionResource(tfAction map[string]interface{}) octopusdeploy
 		resource.Properties["Octopus.Action.SubstituteInFiles.TargetFiles"] = variableSubstitutionInFiles
 		resource.Properties["Octopus.Action.SubstituteInFiles.Enabled"] = "True"
 
-		resource.Properties["Octopus.Action.EnabledFeatures"] += ",Octopus.Features.SubstituteInFiles"
+		enableFeatures(resource.Properties, featureSubstituteInFiles)
 	}
 
 	return resource
 }
 
-func buildRunScriptFromPackageActionResource(tfAction map[string]interface{}) map[string]string {
+// getScriptSource returns the configured script source, or infers it from the script attributes
+func getScriptSource(tfAction map[string]interface{}) string {
+	if scriptSource := getStringOrEmpty(tfAction["script_source"]); scriptSource != "" {
+		return scriptSource
+	}
+
+	if getStringOrEmpty(tfAction["script_body"]) != "" {
+		return scriptSourceInline
+	}
+
+	return scriptSourcePackage
+}
+
+// validateScriptSource checks that exactly one of script_body and script_file_name is set, and that it
+// agrees with script_source
+func validateScriptSource(tfAction map[string]interface{}) error {
+	name := tfAction["name"].(string)
+	scriptBody := getStringOrEmpty(tfAction["script_body"])
+	scriptFileName := getStringOrEmpty(tfAction["script_file_name"])
+
+	if scriptBody != "" && scriptFileName != "" {
+		return fmt.Errorf("action %s: only one of script_body and script_file_name can be set", name)
+	}
+
+	if scriptBody == "" && scriptFileName == "" {
+		return fmt.Errorf("action %s: one of script_body or script_file_name must be set", name)
+	}
+
+	scriptSource := getStringOrEmpty(tfAction["script_source"])
+
+	if scriptSource == scriptSourceInline && scriptBody == "" {
+		return fmt.Errorf("action %s: script_body must be set when script_source is '%s'", name, scriptSourceInline)
+	}
+
+	if scriptSource == scriptSourcePackage && scriptFileName == "" {
+		return fmt.Errorf("action %s: script_file_name must be set when script_source is '%s'", name, scriptSourcePackage)
+	}
+
+	return nil
+}
+
+func buildScriptSourceProperties(tfAction map[string]interface{}) map[string]string {
 
 	properties := make(map[string]string)
 
-	properties["Octopus.Action.Script.ScriptFileName"] = tfAction["script_file_name"].(string)
+	scriptSource := getScriptSource(tfAction)
+
+	properties["Octopus.Action.Script.ScriptSource"] = scriptSource
 	properties["Octopus.Action.Script.ScriptParameters"] = tfAction["script_parameters"].(string)
-	properties["Octopus.Action.Script.ScriptSource"] = "Package"
+
+	if scriptSource == scriptSourceInline {
+		properties["Octopus.Action.Script.ScriptBody"] = tfAction["script_body"].(string)
+		properties["Octopus.Action.Script.Syntax"] = tfAction["syntax"].(string)
+	} else {
+		properties["Octopus.Action.Script.ScriptFileName"] = tfAction["script_file_name"].(string)
+	}
 
 	return properties
 }
+
+// flattenScriptSourceProperties updates the script attributes of the action from the properties Octopus returned
+func flattenScriptSourceProperties(tfAction map[string]interface{}, properties map[string]string) {
+	scriptSource := properties["Octopus.Action.Script.ScriptSource"]
+	if scriptSource == "" {
+		scriptSource = scriptSourcePackage
+	}
+
+	tfAction["script_source"] = scriptSource
+	tfAction["script_parameters"] = properties["Octopus.Action.Script.ScriptParameters"]
+
+	if scriptSource == scriptSourceInline {
+		tfAction["script_body"] = properties["Octopus.Action.Script.ScriptBody"]
+		tfAction["script_file_name"] = ""
+
+		if syntax := properties["Octopus.Action.Script.Syntax"]; syntax != "" {
+			tfAction["syntax"] = syntax
+		}
+	} else {
+		tfAction["script_body"] = ""
+		tfAction["script_file_name"] = properties["Octopus.Action.Script.ScriptFileName"]
+	}
+}
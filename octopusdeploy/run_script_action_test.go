@@ -2,9 +2,9 @@ package octopusdeploy
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
-	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -45,7 +45,7 @@ func testAccRunScriptAction() string {
 
 func testAccCheckRunScriptAction() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*octopusdeploy.Client)
+		client := testAccProvider.Meta().(*providerMeta).Client
 
 		process, err := getDeploymentProcess(s, client)
 		if err != nil {
@@ -73,3 +73,78 @@ func testAccCheckRunScriptAction() resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestAccOctopusDeployRunScriptActionInline(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunScriptActionInline(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRunScriptActionInline(),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOctopusDeployRunScriptActionBothSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBuildTestAction(`
+					run_script_action {
+						name = "Run Script"
+						script_body = "Write-Host 'Hello'"
+						script_file_name = "Test.ps1"
+					}
+				`),
+				ExpectError: regexp.MustCompile("only one of script_body and script_file_name can be set"),
+			},
+		},
+	})
+}
+
+func testAccRunScriptActionInline() string {
+	return testAccBuildTestAction(`
+		run_script_action {
+            name = "Run Script"
+            run_on_server = true
+			
+			script_body = "echo $1"
+			syntax = "Bash"
+			script_parameters = "hello"
+        }
+	`)
+}
+
+func testAccCheckRunScriptActionInline() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.Properties["Octopus.Action.Script.ScriptSource"] != "Inline" {
+			return fmt.Errorf("ScriptSource is incorrect: %s", action.Properties["Octopus.Action.Script.ScriptSource"])
+		}
+
+		if action.Properties["Octopus.Action.Script.ScriptBody"] != "echo $1" {
+			return fmt.Errorf("ScriptBody is incorrect: %s", action.Properties["Octopus.Action.Script.ScriptBody"])
+		}
+
+		if action.Properties["Octopus.Action.Script.Syntax"] != "Bash" {
+			return fmt.Errorf("Syntax is incorrect: %s", action.Properties["Octopus.Action.Script.Syntax"])
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,46 @@
+package octopusdeploy
+
+import (
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func getTomcatStateActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addTomcatManagerSchema(element)
+
+	element.Schema["context_path"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The context path of the application to start or stop",
+		Required:    true,
+	}
+
+	element.Schema["deployment_version"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The version of the application, for parallel deployments",
+		Optional:    true,
+	}
+
+	element.Schema["enabled"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Start the application if true, stop it if false",
+		Required:    true,
+	}
+
+	return actionSchema
+}
+
+func buildTomcatStateActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.TomcatState"
+
+	resource.Properties = merge(resource.Properties, buildTomcatManagerProperties(tfAction))
+
+	resource.Properties["Tomcat.Deploy.Name"] = tfAction["context_path"].(string)
+	resource.Properties["Tomcat.Deploy.Version"] = tfAction["deployment_version"].(string)
+	resource.Properties["Tomcat.Deploy.Enabled"] = formatBool(tfAction["enabled"].(bool))
+
+	return resource
+}
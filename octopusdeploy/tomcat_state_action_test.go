@@ -0,0 +1,63 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployTomcatStateAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTomcatStateAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTomcatStateAction(),
+				),
+			},
+		},
+	})
+}
+
+func testAccTomcatStateAction() string {
+	return testAccBuildTestAction(`
+		tomcat_state_action {
+			name = "Stop App"
+			manager_url = "http://localhost:8080/manager"
+			context_path = "/app"
+			enabled = false
+		}
+	`)
+}
+
+func testAccCheckTomcatStateAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.TomcatState" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Tomcat.Deploy.Name"] != "/app" {
+			return fmt.Errorf("Name is incorrect: %s", action.Properties["Tomcat.Deploy.Name"])
+		}
+
+		if action.Properties["Tomcat.Deploy.Enabled"] != "False" {
+			return fmt.Errorf("Enabled is incorrect: %s", action.Properties["Tomcat.Deploy.Enabled"])
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,199 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// helmValuesSource is an entry of the Octopus.Action.Helm.TemplateValuesSources property. Later sources
+// override the values of earlier ones.
+type helmValuesSource struct {
+	Type            string      `json:"Type"`
+	PackageID       string      `json:"PackageId,omitempty"`
+	PackageFeedID   string      `json:"PackageFeedId,omitempty"`
+	PackageName     string      `json:"PackageName,omitempty"`
+	ValuesFilePaths string      `json:"ValuesFilePaths,omitempty"`
+	Value           interface{} `json:"Value,omitempty"`
+}
+
+func getUpgradeHelmChartActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addExecutionLocationSchema(element)
+	addWorkerPoolSchema(element)
+	addPrimaryPackageSchema(element, true)
+	element.Schema["primary_package"].Description = "The Helm chart, from a Helm feed"
+
+	element.Schema["release_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the Helm release",
+		Required:    true,
+	}
+
+	element.Schema["namespace"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The namespace to install the release into. Defaults to the namespace of the target",
+		Optional:    true,
+	}
+
+	element.Schema["reset_values"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Reset the values of the release to those in the chart before applying the configured values",
+		Optional:    true,
+		Default:     true,
+	}
+
+	element.Schema["helm_client_version"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "The major version of the Helm client, either 'V3' or 'V2'",
+		Optional:     true,
+		Default:      "V3",
+		ValidateFunc: validateValueFunc([]string{"V2", "V3"}),
+	}
+
+	element.Schema["custom_helm_executable"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The path to a Helm executable to use instead of the one on the path",
+		Optional:    true,
+	}
+
+	element.Schema["additional_args"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Additional arguments passed to helm upgrade",
+		Optional:    true,
+	}
+
+	element.Schema["values_source"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Sources of values for the chart, in order. Values from later sources override earlier ones",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:         schema.TypeString,
+					Description:  "One of 'Chart', 'Package', 'InlineYaml' or 'KeyValues'",
+					Required:     true,
+					ValidateFunc: validateValueFunc([]string{"Chart", "Package", "InlineYaml", "KeyValues"}),
+				},
+				"package_id": {
+					Type:        schema.TypeString,
+					Description: "The package containing the values files, for 'Package' sources",
+					Optional:    true,
+				},
+				"feed_id": {
+					Type:        schema.TypeString,
+					Description: "The feed of the package, for 'Package' sources",
+					Optional:    true,
+					Default:     "feeds-builtin",
+				},
+				"values_file_paths": {
+					Type:        schema.TypeString,
+					Description: "A newline-separated list of values files in the chart or package, for 'Chart' and 'Package' sources",
+					Optional:    true,
+				},
+				"yaml": {
+					Type:        schema.TypeString,
+					Description: "The values as YAML, for 'InlineYaml' sources",
+					Optional:    true,
+				},
+				"key_values": {
+					Type:        schema.TypeMap,
+					Description: "The values as key/value pairs, for 'KeyValues' sources",
+					Optional:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+
+	return actionSchema
+}
+
+// validateHelmValuesSources checks that each values source has the attributes its type needs
+func validateHelmValuesSources(tfAction map[string]interface{}) error {
+	name := tfAction["name"].(string)
+
+	for i, tfSource := range tfAction["values_source"].([]interface{}) {
+		tfSourceMap := tfSource.(map[string]interface{})
+
+		switch tfSourceMap["type"].(string) {
+		case "Chart":
+			if tfSourceMap["values_file_paths"].(string) == "" {
+				return fmt.Errorf("action %s: values_source %d: values_file_paths must be set for 'Chart' sources", name, i)
+			}
+		case "Package":
+			if tfSourceMap["package_id"].(string) == "" || tfSourceMap["values_file_paths"].(string) == "" {
+				return fmt.Errorf("action %s: values_source %d: package_id and values_file_paths must be set for 'Package' sources", name, i)
+			}
+		case "InlineYaml":
+			if tfSourceMap["yaml"].(string) == "" {
+				return fmt.Errorf("action %s: values_source %d: yaml must be set for 'InlineYaml' sources", name, i)
+			}
+		case "KeyValues":
+			if len(tfSourceMap["key_values"].(map[string]interface{})) == 0 {
+				return fmt.Errorf("action %s: values_source %d: key_values must be set for 'KeyValues' sources", name, i)
+			}
+		}
+	}
+
+	return nil
+}
+
+func buildUpgradeHelmChartActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.HelmChartUpgrade"
+
+	resource.Properties["Octopus.Action.Helm.ReleaseName"] = tfAction["release_name"].(string)
+	resource.Properties["Octopus.Action.Helm.Namespace"] = tfAction["namespace"].(string)
+	resource.Properties["Octopus.Action.Helm.ResetValues"] = formatBool(tfAction["reset_values"].(bool))
+	resource.Properties["Octopus.Action.Helm.ClientVersion"] = tfAction["helm_client_version"].(string)
+	resource.Properties["Octopus.Action.Helm.CustomHelmExecutable"] = tfAction["custom_helm_executable"].(string)
+	resource.Properties["Octopus.Action.Helm.AdditionalArgs"] = tfAction["additional_args"].(string)
+
+	sources := []helmValuesSource{}
+	valuesPackages := 0
+
+	for _, tfSource := range tfAction["values_source"].([]interface{}) {
+		tfSourceMap := tfSource.(map[string]interface{})
+		source := helmValuesSource{
+			Type: tfSourceMap["type"].(string),
+		}
+
+		switch source.Type {
+		case "Chart":
+			source.ValuesFilePaths = tfSourceMap["values_file_paths"].(string)
+		case "Package":
+			// Each values package is an additional package reference, which the source refers to by name
+			valuesPackages++
+			source.PackageName = fmt.Sprintf("ValuesPack-%d", valuesPackages)
+			source.PackageID = tfSourceMap["package_id"].(string)
+			source.PackageFeedID = tfSourceMap["feed_id"].(string)
+			source.ValuesFilePaths = tfSourceMap["values_file_paths"].(string)
+
+			resource.Packages = append(resource.Packages, octopusdeploy.PackageReference{
+				Name:                source.PackageName,
+				PackageId:           source.PackageID,
+				FeedId:              source.PackageFeedID,
+				AcquisitionLocation: (string)(octopusdeploy.PackageAcquisitionLocation_Server),
+				Properties:          map[string]string{"Extract": "True"},
+			})
+		case "InlineYaml":
+			source.Value = tfSourceMap["yaml"].(string)
+		case "KeyValues":
+			source.Value = buildStringMap(tfSourceMap["key_values"])
+		}
+
+		sources = append(sources, source)
+	}
+
+	j, _ := json.Marshal(sources)
+	resource.Properties["Octopus.Action.Helm.TemplateValuesSources"] = string(j)
+
+	return resource
+}
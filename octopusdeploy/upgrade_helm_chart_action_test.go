@@ -0,0 +1,87 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployUpgradeHelmChartAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUpgradeHelmChartAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUpgradeHelmChartAction(),
+				),
+			},
+		},
+	})
+}
+
+func testAccUpgradeHelmChartAction() string {
+	return testAccBuildTestAction(`
+		upgrade_helm_chart_action {
+			name = "Upgrade Chart"
+			run_on_server = true
+
+			primary_package {
+				package_id = "nginx"
+			}
+
+			release_name = "web"
+			namespace = "apps"
+			additional_args = "--atomic"
+
+			values_source {
+				type = "Package"
+				package_id = "web-values"
+				values_file_paths = "values.yaml"
+			}
+
+			values_source {
+				type = "KeyValues"
+				key_values = {
+					replicaCount = "2"
+				}
+			}
+		}
+	`)
+}
+
+func testAccCheckUpgradeHelmChartAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.HelmChartUpgrade" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Octopus.Action.Helm.ReleaseName"] != "web" {
+			return fmt.Errorf("ReleaseName is incorrect: %s", action.Properties["Octopus.Action.Helm.ReleaseName"])
+		}
+
+		if len(action.Packages) != 2 {
+			return fmt.Errorf("Expected the chart and a values package but found %d packages", len(action.Packages))
+		}
+
+		expectedSources := `[{"Type":"Package","PackageId":"web-values","PackageFeedId":"feeds-builtin","PackageName":"ValuesPack-1","ValuesFilePaths":"values.yaml"},{"Type":"KeyValues","Value":{"replicaCount":"2"}}]`
+		if action.Properties["Octopus.Action.Helm.TemplateValuesSources"] != expectedSources {
+			return fmt.Errorf("TemplateValuesSources is incorrect: %s", action.Properties["Octopus.Action.Helm.TemplateValuesSources"])
+		}
+
+		return nil
+	}
+}
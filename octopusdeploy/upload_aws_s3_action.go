@@ -0,0 +1,247 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	s3TargetModeEntirePackage  = "EntirePackage"
+	s3TargetModeFileSelections = "FileSelections"
+)
+
+var s3CannedAcls = []string{
+	"private",
+	"public-read",
+	"public-read-write",
+	"aws-exec-read",
+	"authenticated-read",
+	"bucket-owner-read",
+	"bucket-owner-full-control",
+}
+
+// s3PackageOptions is the Octopus.Action.Aws.S3.PackageOptions property, used when uploading the whole package
+type s3PackageOptions struct {
+	BucketKey          string       `json:"bucketKey"`
+	BucketKeyBehaviour string       `json:"bucketKeyBehaviour"`
+	BucketKeyPrefix    string       `json:"bucketKeyPrefix"`
+	StorageClass       string       `json:"storageClass"`
+	CannedAcl          string       `json:"cannedAcl"`
+	Metadata           []awsKeyValue `json:"metadata"`
+	Tags               []awsKeyValue `json:"tags"`
+}
+
+// s3FileSelection is an entry of the Octopus.Action.Aws.S3.FileSelections property
+type s3FileSelection struct {
+	Type                        string       `json:"type"`
+	Path                        string       `json:"path,omitempty"`
+	Pattern                     string       `json:"pattern,omitempty"`
+	BucketKey                   string       `json:"bucketKey,omitempty"`
+	BucketKeyBehaviour          string       `json:"bucketKeyBehaviour"`
+	BucketKeyPrefix             string       `json:"bucketKeyPrefix"`
+	PerformVariableSubstitution string       `json:"performVariableSubstitution"`
+	StorageClass                string       `json:"storageClass"`
+	CannedAcl                   string       `json:"cannedAcl"`
+	Metadata                    []awsKeyValue `json:"metadata"`
+	Tags                        []awsKeyValue `json:"tags"`
+}
+
+// getS3ObjectOptionsSchema returns the options shared by the whole package and individual file uploads
+func getS3ObjectOptionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bucket_key": {
+			Type:        schema.TypeString,
+			Description: "The key of the object. Defaults to the file name, or the package file name",
+			Optional:    true,
+		},
+		"bucket_key_prefix": {
+			Type:        schema.TypeString,
+			Description: "A prefix for the key, e.g. a folder path ending in '/'",
+			Optional:    true,
+		},
+		"storage_class": {
+			Type:         schema.TypeString,
+			Description:  "The S3 storage class of the objects",
+			Optional:     true,
+			Default:      "STANDARD",
+			ValidateFunc: validateValueFunc([]string{"STANDARD", "REDUCED_REDUNDANCY", "STANDARD_IA", "ONEZONE_IA", "INTELLIGENT_TIERING", "GLACIER", "DEEP_ARCHIVE"}),
+		},
+		"canned_acl": {
+			Type:         schema.TypeString,
+			Description:  "The canned ACL applied to the objects",
+			Optional:     true,
+			Default:      "private",
+			ValidateFunc: validateValueFunc(s3CannedAcls),
+		},
+		"metadata": {
+			Type:        schema.TypeMap,
+			Description: "Metadata set on the objects",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tags": {
+			Type:        schema.TypeMap,
+			Description: "Tags set on the objects",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+func getUploadAwsS3ActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addExecutionLocationSchema(element)
+	addWorkerPoolSchema(element)
+	addPrimaryPackageSchema(element, true)
+	addAwsAccountSchema(element)
+
+	element.Schema["bucket_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the bucket to upload to",
+		Required:    true,
+	}
+
+	packageOptions := getS3ObjectOptionsSchema()
+	element.Schema["entire_package"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Upload the package as a single object",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: packageOptions,
+		},
+	}
+
+	fileOptions := getS3ObjectOptionsSchema()
+	fileOptions["path"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The path of a single file in the package to upload",
+		Optional:    true,
+	}
+	fileOptions["pattern"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "A glob pattern of the files in the package to upload, e.g. 'content/**/*'",
+		Optional:    true,
+	}
+	fileOptions["substitute_variables"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Replace variables in the files before uploading them",
+		Optional:    true,
+		Default:     false,
+	}
+	element.Schema["file_selection"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Upload individual files of the package. Each selection is either a single path or a pattern",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: fileOptions,
+		},
+	}
+
+	return actionSchema
+}
+
+// validateUploadAwsS3Action checks the credentials, and that the action uploads either the entire package or
+// file selections
+func validateUploadAwsS3Action(tfAction map[string]interface{}) error {
+	if err := validateAwsAccount(tfAction); err != nil {
+		return err
+	}
+
+	name := tfAction["name"].(string)
+	hasEntirePackage := len(tfAction["entire_package"].([]interface{})) > 0
+	tfFileSelections := tfAction["file_selection"].([]interface{})
+
+	if hasEntirePackage == (len(tfFileSelections) > 0) {
+		return fmt.Errorf("action %s: exactly one of entire_package and file_selection must be set", name)
+	}
+
+	for i, tfFileSelection := range tfFileSelections {
+		tfFileSelectionMap := tfFileSelection.(map[string]interface{})
+		hasPath := tfFileSelectionMap["path"].(string) != ""
+		hasPattern := tfFileSelectionMap["pattern"].(string) != ""
+
+		if hasPath == hasPattern {
+			return fmt.Errorf("action %s: file_selection %d: exactly one of path and pattern must be set", name, i)
+		}
+	}
+
+	return nil
+}
+
+// getS3BucketKeyBehaviour returns whether Octopus uses the configured key, or the file name with an optional prefix
+func getS3BucketKeyBehaviour(tfOptions map[string]interface{}) string {
+	if tfOptions["bucket_key"].(string) != "" {
+		return "Custom"
+	}
+
+	return "Filename"
+}
+
+func buildUploadAwsS3ActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.AwsUploadS3"
+
+	resource.Properties = merge(resource.Properties, buildAwsAccountProperties(tfAction))
+
+	resource.Properties["Octopus.Action.Aws.S3.BucketName"] = tfAction["bucket_name"].(string)
+
+	if tfOptions := getSingleBlock(tfAction["entire_package"]); tfOptions != nil {
+		resource.Properties["Octopus.Action.Aws.S3.TargetMode"] = s3TargetModeEntirePackage
+
+		j, _ := json.Marshal(s3PackageOptions{
+			BucketKey:          tfOptions["bucket_key"].(string),
+			BucketKeyBehaviour: getS3BucketKeyBehaviour(tfOptions),
+			BucketKeyPrefix:    tfOptions["bucket_key_prefix"].(string),
+			StorageClass:       tfOptions["storage_class"].(string),
+			CannedAcl:          tfOptions["canned_acl"].(string),
+			Metadata:           buildAwsKeyValues(tfOptions["metadata"]),
+			Tags:               buildAwsKeyValues(tfOptions["tags"]),
+		})
+		resource.Properties["Octopus.Action.Aws.S3.PackageOptions"] = string(j)
+
+		return resource
+	}
+
+	resource.Properties["Octopus.Action.Aws.S3.TargetMode"] = s3TargetModeFileSelections
+
+	fileSelections := []s3FileSelection{}
+	for _, tfFileSelection := range tfAction["file_selection"].([]interface{}) {
+		tfFileSelectionMap := tfFileSelection.(map[string]interface{})
+
+		fileSelection := s3FileSelection{
+			Type:                        "SingleFile",
+			Path:                        tfFileSelectionMap["path"].(string),
+			BucketKey:                   tfFileSelectionMap["bucket_key"].(string),
+			BucketKeyBehaviour:          getS3BucketKeyBehaviour(tfFileSelectionMap),
+			BucketKeyPrefix:             tfFileSelectionMap["bucket_key_prefix"].(string),
+			PerformVariableSubstitution: formatBool(tfFileSelectionMap["substitute_variables"].(bool)),
+			StorageClass:                tfFileSelectionMap["storage_class"].(string),
+			CannedAcl:                   tfFileSelectionMap["canned_acl"].(string),
+			Metadata:                    buildAwsKeyValues(tfFileSelectionMap["metadata"]),
+			Tags:                        buildAwsKeyValues(tfFileSelectionMap["tags"]),
+		}
+
+		if pattern := tfFileSelectionMap["pattern"].(string); pattern != "" {
+			fileSelection.Type = "MultipleFiles"
+			fileSelection.Path = ""
+			fileSelection.Pattern = pattern
+		}
+
+		fileSelections = append(fileSelections, fileSelection)
+	}
+
+	j, _ := json.Marshal(fileSelections)
+	resource.Properties["Octopus.Action.Aws.S3.FileSelections"] = string(j)
+
+	return resource
+}
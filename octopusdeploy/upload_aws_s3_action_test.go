@@ -0,0 +1,88 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployUploadAwsS3Action(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUploadAwsS3Action(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUploadAwsS3Action(),
+				),
+			},
+		},
+	})
+}
+
+func testAccUploadAwsS3Action() string {
+	return testAccBuildTestAction(`
+		upload_aws_s3_action {
+			name = "Upload Site"
+			run_on_server = true
+			region = "us-east-1"
+			aws_account_variable = "AWS Account"
+			bucket_name = "my-bucket"
+
+			primary_package {
+				package_id = "MySite"
+			}
+
+			file_selection {
+				pattern = "content/**/*"
+				bucket_key_prefix = "site/"
+				canned_acl = "public-read"
+
+				metadata = {
+					Cache-Control = "max-age=60"
+				}
+
+				tags = {
+					team = "web"
+					environment = "production"
+				}
+			}
+		}
+	`)
+}
+
+func testAccCheckUploadAwsS3Action() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.AwsUploadS3" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Octopus.Action.Aws.S3.BucketName"] != "my-bucket" {
+			return fmt.Errorf("BucketName is incorrect: %s", action.Properties["Octopus.Action.Aws.S3.BucketName"])
+		}
+
+		if action.Properties["Octopus.Action.Aws.S3.TargetMode"] != "FileSelections" {
+			return fmt.Errorf("TargetMode is incorrect: %s", action.Properties["Octopus.Action.Aws.S3.TargetMode"])
+		}
+
+		expected := `[{"type":"MultipleFiles","pattern":"content/**/*","bucketKeyBehaviour":"Filename","bucketKeyPrefix":"site/","performVariableSubstitution":"False","storageClass":"STANDARD","cannedAcl":"public-read","metadata":[{"key":"Cache-Control","value":"max-age=60"}],"tags":[{"key":"environment","value":"production"},{"key":"team","value":"web"}]}]`
+		if action.Properties["Octopus.Action.Aws.S3.FileSelections"] != expected {
+			return fmt.Errorf("FileSelections is incorrect: %s", action.Properties["Octopus.Action.Aws.S3.FileSelections"])
+		}
+
+		return nil
+	}
+}
@@ -2,6 +2,7 @@ package octopusdeploy
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
 	"github.com/hashicorp/terraform/helper/mutexkv"
@@ -32,6 +33,30 @@ func validateValueFunc(values []string) schema.SchemaValidateFunc {
 	}
 }
 
+func validateIntValueFunc(values []int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (we []string, errors []error) {
+		value := v.(int)
+		for _, val := range values {
+			if value == val {
+				return
+			}
+		}
+
+		errors = append(errors, fmt.Errorf("%d is an invalid value for argument %s. Must be one of %v", value, k, values))
+		return
+	}
+}
+
+func validateIntAtLeastFunc(min int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (we []string, errors []error) {
+		value := v.(int)
+		if value < min {
+			errors = append(errors, fmt.Errorf("%d is an invalid value for argument %s. Must be at least %d", value, k, min))
+		}
+		return
+	}
+}
+
 // validateStringInSlice checks if a string is in the given slice
 func validateStringInSlice(str string, list []string) bool {
 	for _, v := range list {
@@ -53,6 +78,17 @@ func validateAllSliceItemsInSlice(givenSlice, validationSlice []string) (string,
 	return "", true
 }
 
+// getSortedKeys returns the keys of the map in ascending order, for serialising maps in a stable order
+func getSortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
 func getSliceFromTerraformTypeList(inputTypeList interface{}) []string {
 	var newSlice []string
 
@@ -0,0 +1,45 @@
+package octopusdeploy
+
+import (
+	"strings"
+
+	"github.com/mshetland/go-octopusdeploy/octopusdeploy"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func getWildFlyStateActionSchema() *schema.Schema {
+
+	actionSchema, element := getCommonDeploymentActionSchema()
+	addWildFlyManagementSchema(element)
+	addWildFlyServerGroupsSchema(element)
+
+	element.Schema["deployment_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the deployment to enable or disable",
+		Required:    true,
+	}
+
+	element.Schema["enabled"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Enable the deployment if true, disable it if false, for standalone servers",
+		Optional:    true,
+		Default:     true,
+	}
+
+	return actionSchema
+}
+
+func buildWildFlyStateActionResource(tfAction map[string]interface{}) octopusdeploy.DeploymentAction {
+	resource := buildDeploymentActionResource(tfAction)
+
+	resource.ActionType = "Octopus.WildFlyState"
+
+	resource.Properties = merge(resource.Properties, buildWildFlyManagementProperties(tfAction))
+
+	resource.Properties["WildFly.Deploy.Name"] = tfAction["deployment_name"].(string)
+	resource.Properties["WildFly.Deploy.Enabled"] = formatBool(tfAction["enabled"].(bool))
+	resource.Properties["WildFly.Deploy.EnabledServerGroup"] = strings.Join(getSliceFromTerraformTypeList(tfAction["enabled_server_groups"]), ",")
+	resource.Properties["WildFly.Deploy.DisabledServerGroup"] = strings.Join(getSliceFromTerraformTypeList(tfAction["disabled_server_groups"]), ",")
+
+	return resource
+}
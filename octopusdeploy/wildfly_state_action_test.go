@@ -0,0 +1,66 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOctopusDeployWildFlyStateAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOctopusDeployDeploymentProcessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWildFlyStateAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWildFlyStateAction(),
+				),
+			},
+		},
+	})
+}
+
+func testAccWildFlyStateAction() string {
+	return testAccBuildTestAction(`
+		wildfly_state_action {
+			name = "Disable App"
+			deployment_name = "app.war"
+			enabled = false
+		}
+	`)
+}
+
+func testAccCheckWildFlyStateAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerMeta).Client
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.WildFlyState" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["WildFly.Deploy.Name"] != "app.war" {
+			return fmt.Errorf("Name is incorrect: %s", action.Properties["WildFly.Deploy.Name"])
+		}
+
+		if action.Properties["WildFly.Deploy.Enabled"] != "False" {
+			return fmt.Errorf("Enabled is incorrect: %s", action.Properties["WildFly.Deploy.Enabled"])
+		}
+
+		if action.Properties["WildFly.Deploy.Protocol"] != "remote+http" {
+			return fmt.Errorf("Protocol is incorrect: %s", action.Properties["WildFly.Deploy.Protocol"])
+		}
+
+		return nil
+	}
+}